@@ -0,0 +1,186 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+const activityEntryIDPathKey = "entryID"
+
+// possible physical activity log errors designations
+const (
+	errorActivityEntryNotFound      = "ActivityEntryNotFound"
+	errorInvalidActivityType        = "InvalidActivityType"
+	errorInvalidActivityIntensity   = "InvalidActivityIntensity"
+	errorInvalidActivityDuration    = "InvalidActivityDuration"
+	errorInvalidActivityLogCriteria = "InvalidActivityLogCriteria"
+)
+
+func (r *Router) initActivityRoutes(customerAPI *echo.Group) {
+	activity := customerAPI.Group("/activity", r.identifyUser, r.verifyCustomer)
+	{
+		activity.GET("", r.getUserActivityLog)
+		activity.POST("", r.createActivityEntry)
+		activity.DELETE(fmt.Sprintf("/:%v", activityEntryIDPathKey), r.deleteActivityEntry)
+		activity.GET("/compendium", r.getActivityCompendium)
+		activity.GET("/weekly", r.getUserActivityWeeklyProgress)
+		activity.GET("/summary", r.getUserActivitySummary)
+	}
+}
+
+// getActivityLogRequest represents the listing parameters of the user physical activity log.
+type getActivityLogRequest struct {
+	From string `query:"from"` // DateLayout
+	To   string `query:"to"`   // DateLayout
+}
+
+func (r getActivityLogRequest) criteria(userID uint64) (domain.ActivityLogCriteria, error) {
+	criteria := domain.ActivityLogCriteria{
+		UserID: userID,
+	}
+
+	var err error
+	if r.From != "" {
+		criteria.From, err = time.Parse(model.DateLayout, r.From)
+		if err != nil {
+			return domain.ActivityLogCriteria{}, err
+		}
+	}
+	if r.To != "" {
+		criteria.To, err = time.Parse(model.DateLayout, r.To)
+		if err != nil {
+			return domain.ActivityLogCriteria{}, err
+		}
+	}
+
+	return criteria, nil
+}
+
+type getUserActivityLogResponse struct {
+	Entries []*domain.ActivityEntry `json:"entries"`
+}
+
+func (r *Router) getUserActivityLog(c echo.Context) error {
+	var reqData getActivityLogRequest
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	criteria, err := reqData.criteria(c.Get(ctxKeyUserID).(uint64))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	entries, err := r.services.Activity().FindAll(criteria)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidActivityLogCriteria) {
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidActivityLogCriteria))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, &getUserActivityLogResponse{
+		Entries: entries,
+	})
+}
+
+func (r *Router) createActivityEntry(c echo.Context) error {
+	var reqData domain.ActivityEntry
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	reqData.UserID = c.Get(ctxKeyUserID).(uint64)
+
+	if err := r.services.Activity().Create(reqData); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidActivityType):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidActivityType))
+		case errors.Is(err, domain.ErrInvalidActivityIntensity):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidActivityIntensity))
+		case errors.Is(err, domain.ErrInvalidActivityDuration):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidActivityDuration))
+		case errors.Is(err, domain.ErrInvalidActivityData):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidRequestData))
+		default:
+			return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+		}
+	}
+
+	return c.JSON(http.StatusOK, newResult(resultCreated))
+}
+
+func (r *Router) deleteActivityEntry(c echo.Context) error {
+	entryID, err := strconv.ParseUint(c.Param(activityEntryIDPathKey), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	if err = r.services.Activity().Delete(entryID, userID); err != nil {
+		if errors.Is(err, domain.ErrActivityEntryNotFound) {
+			return c.JSON(http.StatusNotFound, newError(c, err, errorActivityEntryNotFound))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, newResult(resultDeleted))
+}
+
+type getActivityCompendiumResponse struct {
+	Activities []*domain.ActivityType `json:"activities"`
+}
+
+func (r *Router) getActivityCompendium(c echo.Context) error {
+	return c.JSON(http.StatusOK, &getActivityCompendiumResponse{
+		Activities: r.services.Activity().Compendium(),
+	})
+}
+
+type getUserActivityWeeklyProgressResponse struct {
+	Weeks []*domain.ActivityWeeklyProgress `json:"weeks"`
+}
+
+func (r *Router) getUserActivityWeeklyProgress(c echo.Context) error {
+	var reqData getActivityLogRequest
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	criteria, err := reqData.criteria(c.Get(ctxKeyUserID).(uint64))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	weeks, err := r.services.Activity().WeeklyProgress(criteria)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidActivityLogCriteria) {
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidActivityLogCriteria))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, &getUserActivityWeeklyProgressResponse{
+		Weeks: weeks,
+	})
+}
+
+func (r *Router) getUserActivitySummary(c echo.Context) error {
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	summary, err := r.services.Activity().RecentSummary(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, summary)
+}
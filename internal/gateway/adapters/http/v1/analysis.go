@@ -31,23 +31,49 @@ func (r *Router) initAnalysesRoutes(customerAPI *echo.Group) {
 		analyses.GET("", r.getUserAnalyses)
 		analyses.POST("", r.createAnalysisRecord)
 		analyses.PUT(fmt.Sprintf("/:%v", analysisIDPathKey), r.updateAnalysisRecord)
+		analyses.DELETE(fmt.Sprintf("/:%v", analysisIDPathKey), r.deleteAnalysisRecord)
+		analyses.GET(fmt.Sprintf("/:%v/history", analysisIDPathKey), r.getAnalysisRecordHistory)
 	}
 }
 
 type getUserAnalysesResponse struct {
-	Analyses []*domain.Analysis `json:"analyses"`
+	Analyses   []*domain.Analysis `json:"analyses"`
+	NextCursor uint64             `json:"nextCursor,omitempty"`
 }
 
 func (r *Router) getUserAnalyses(c echo.Context) error {
+	var reqData getRecordsRequest
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
 	userID := c.Get(ctxKeyUserID).(uint64)
 
-	analyses, err := r.services.Analysis().FindAll(userID)
+	criteria, err := reqData.criteria(userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	analyses, nextCursor, err := r.services.Analysis().Find(criteria)
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidRecordsCriteria) {
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidRecordsCriteria))
+		}
 		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
 	}
 
+	preferences, err := r.services.Preferences().Get(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	for _, analysis := range analyses {
+		analysis.ConvertUnits(preferences.UnitSystem)
+	}
+
 	return c.JSON(http.StatusOK, &getUserAnalysesResponse{
-		Analyses: analyses,
+		Analyses:   analyses,
+		NextCursor: nextCursor,
 	})
 }
 
@@ -75,6 +101,8 @@ func (r *Router) createAnalysisRecord(c echo.Context) error {
 			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidAtherogenicityCoefficient))
 		case errors.Is(err, domain.ErrInvalidCreatinine):
 			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidCreatinine))
+		case errors.Is(err, domain.ErrInvalidUnits):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidUnits))
 		case errors.Is(err, domain.ErrInvalidAnalysisData):
 			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidRequestData))
 		default:
@@ -115,6 +143,8 @@ func (r *Router) updateAnalysisRecord(c echo.Context) error {
 			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidAtherogenicityCoefficient))
 		case errors.Is(err, domain.ErrInvalidCreatinine):
 			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidCreatinine))
+		case errors.Is(err, domain.ErrInvalidUnits):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidUnits))
 		case errors.Is(err, domain.ErrInvalidAnalysisData):
 			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidRequestData))
 		case errors.Is(err, domain.ErrAnalysisRecordNotFound):
@@ -126,3 +156,55 @@ func (r *Router) updateAnalysisRecord(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, newResult(resultUpdated))
 }
+
+func (r *Router) deleteAnalysisRecord(c echo.Context) error {
+	analysisID, err := strconv.ParseUint(c.Param(analysisIDPathKey), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	if err = r.services.Analysis().Delete(analysisID, userID); err != nil {
+		if errors.Is(err, domain.ErrAnalysisRecordNotFound) {
+			return c.JSON(http.StatusNotFound, newError(c, err, errorAnalysisRecordNotFound))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, newResult(resultDeleted))
+}
+
+type getAnalysisRecordHistoryResponse struct {
+	Revisions []*domain.AnalysisRevision `json:"revisions"`
+}
+
+func (r *Router) getAnalysisRecordHistory(c echo.Context) error {
+	analysisID, err := strconv.ParseUint(c.Param(analysisIDPathKey), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	revisions, err := r.services.Analysis().History(analysisID, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrAnalysisRecordNotFound) {
+			return c.JSON(http.StatusNotFound, newError(c, err, errorAnalysisRecordNotFound))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	preferences, err := r.services.Preferences().Get(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	for _, revision := range revisions {
+		revision.Analysis.ConvertUnits(preferences.UnitSystem)
+	}
+
+	return c.JSON(http.StatusOK, &getAnalysisRecordHistoryResponse{
+		Revisions: revisions,
+	})
+}
@@ -34,18 +34,34 @@ func (r *Router) initBasicIndicatorsRoutes(customerAPI *echo.Group) {
 		basicIndicators.GET("", r.getUserBasicIndicators)
 		basicIndicators.POST("", r.createBasicIndicatorsRecord)
 		basicIndicators.PUT(fmt.Sprintf("/:%v", basicIndicatorsIDPathKey), r.updateBasicIndicatorsRecord)
+		basicIndicators.DELETE(fmt.Sprintf("/:%v", basicIndicatorsIDPathKey), r.deleteBasicIndicatorsRecord)
+		basicIndicators.GET(fmt.Sprintf("/:%v/history", basicIndicatorsIDPathKey), r.getBasicIndicatorsRecordHistory)
 	}
 }
 
 type getUserBasicIndicatorsResponse struct {
 	BasicIndicators []*common.BasicIndicators `json:"basicIndicators"`
+	NextCursor      uint64                    `json:"nextCursor,omitempty"`
 }
 
 func (r *Router) getUserBasicIndicators(c echo.Context) error {
+	var reqData getRecordsRequest
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
 	userID := c.Get(ctxKeyUserID).(uint64)
 
-	basicIndicators, err := r.services.BasicIndicators().FindAll(userID)
+	recordsCriteria, err := reqData.criteria(userID)
 	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	basicIndicators, nextCursor, err := r.services.BasicIndicators().Find(recordsCriteria)
+	if err != nil {
+		if errors.Is(err, common.ErrInvalidRecordsCriteria) {
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidRecordsCriteria))
+		}
 		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
 	}
 
@@ -58,17 +74,23 @@ func (r *Router) getUserBasicIndicators(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
 	}
 
+	preferences, err := r.services.Preferences().Get(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
 	userAge := user.Age()
 
 	for _, basicIndicator := range basicIndicators {
 		if basicIndicator.CVEventsRiskValue != nil {
-			riskValue := float64(*basicIndicator.CVEventsRiskValue)
-			basicIndicator.Scale = r.services.Score().ResolveScale(riskValue, userAge)
+			basicIndicator.Scale = r.services.Score().ResolveScale(*basicIndicator.CVEventsRiskValue, userAge)
 		}
+		basicIndicator.ConvertUnits(preferences.UnitSystem)
 	}
 
 	return c.JSON(http.StatusOK, &getUserBasicIndicatorsResponse{
 		BasicIndicators: basicIndicators,
+		NextCursor:      nextCursor,
 	})
 }
 
@@ -100,6 +122,10 @@ func (r *Router) createBasicIndicatorsRecord(c echo.Context) error {
 			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidCVEventsRiskValue))
 		case errors.Is(err, common.ErrInvalidIdealCardiovascularAgesRange):
 			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidIdealCardiovascularAgesRange))
+		case errors.Is(err, common.ErrInvalidRiskScope):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidRiskScope))
+		case errors.Is(err, common.ErrInvalidUnits):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidUnits))
 		case errors.Is(err, common.ErrInvalidBasicIndicatorsData):
 			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidRequestData))
 		default:
@@ -144,6 +170,10 @@ func (r *Router) updateBasicIndicatorsRecord(c echo.Context) error {
 			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidCVEventsRiskValue))
 		case errors.Is(err, common.ErrInvalidIdealCardiovascularAgesRange):
 			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidIdealCardiovascularAgesRange))
+		case errors.Is(err, common.ErrInvalidRiskScope):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidRiskScope))
+		case errors.Is(err, common.ErrInvalidUnits):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidUnits))
 		case errors.Is(err, common.ErrInvalidBasicIndicatorsData):
 			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidRequestData))
 		case errors.Is(err, common.ErrBasicIndicatorsRecordNotFound):
@@ -155,3 +185,55 @@ func (r *Router) updateBasicIndicatorsRecord(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, newResult(resultUpdated))
 }
+
+func (r *Router) deleteBasicIndicatorsRecord(c echo.Context) error {
+	basicIndicatorsID, err := strconv.ParseUint(c.Param(basicIndicatorsIDPathKey), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	if err = r.services.BasicIndicators().Delete(basicIndicatorsID, userID); err != nil {
+		if errors.Is(err, common.ErrBasicIndicatorsRecordNotFound) {
+			return c.JSON(http.StatusNotFound, newError(c, err, errorBasicIndicatorsRecordNotFound))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, newResult(resultDeleted))
+}
+
+type getBasicIndicatorsRecordHistoryResponse struct {
+	Revisions []*common.BasicIndicatorsRevision `json:"revisions"`
+}
+
+func (r *Router) getBasicIndicatorsRecordHistory(c echo.Context) error {
+	basicIndicatorsID, err := strconv.ParseUint(c.Param(basicIndicatorsIDPathKey), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	revisions, err := r.services.BasicIndicators().History(basicIndicatorsID, userID)
+	if err != nil {
+		if errors.Is(err, common.ErrBasicIndicatorsRecordNotFound) {
+			return c.JSON(http.StatusNotFound, newError(c, err, errorBasicIndicatorsRecordNotFound))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	preferences, err := r.services.Preferences().Get(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	for _, revision := range revisions {
+		revision.BasicIndicators.ConvertUnits(preferences.UnitSystem)
+	}
+
+	return c.JSON(http.StatusOK, &getBasicIndicatorsRecordHistoryResponse{
+		Revisions: revisions,
+	})
+}
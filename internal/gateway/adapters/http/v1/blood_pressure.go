@@ -0,0 +1,172 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+const bloodPressureMeasurementIDPathKey = "measurementID"
+
+// possible blood pressure diary errors designations
+const (
+	errorBloodPressureMeasurementNotFound  = "BloodPressureMeasurementNotFound"
+	errorInvalidSystolicPressure           = "InvalidSystolicPressure"
+	errorInvalidDiastolicPressure          = "InvalidDiastolicPressure"
+	errorInvalidPulse                      = "InvalidPulse"
+	errorInvalidArm                        = "InvalidArm"
+	errorInvalidPosition                   = "InvalidPosition"
+	errorInvalidTimeOfDay                  = "InvalidTimeOfDay"
+	errorInvalidBloodPressureDiaryCriteria = "InvalidBloodPressureDiaryCriteria"
+)
+
+func (r *Router) initBloodPressureRoutes(customerAPI *echo.Group) {
+	bloodPressure := customerAPI.Group("/bloodPressure", r.identifyUser, r.verifyCustomer)
+	{
+		bloodPressure.GET("", r.getUserBloodPressureDiary)
+		bloodPressure.POST("", r.createBloodPressureMeasurement)
+		bloodPressure.DELETE(fmt.Sprintf("/:%v", bloodPressureMeasurementIDPathKey), r.deleteBloodPressureMeasurement)
+		bloodPressure.GET("/weeklyAverages", r.getUserBloodPressureWeeklyAverages)
+	}
+}
+
+// getBloodPressureDiaryRequest represents the listing parameters of the user blood pressure diary.
+type getBloodPressureDiaryRequest struct {
+	From string `query:"from"` // DateLayout
+	To   string `query:"to"`   // DateLayout
+}
+
+func (r getBloodPressureDiaryRequest) criteria(userID uint64) (domain.BloodPressureDiaryCriteria, error) {
+	criteria := domain.BloodPressureDiaryCriteria{
+		UserID: userID,
+	}
+
+	var err error
+	if r.From != "" {
+		criteria.From, err = time.Parse(model.DateLayout, r.From)
+		if err != nil {
+			return domain.BloodPressureDiaryCriteria{}, err
+		}
+	}
+	if r.To != "" {
+		criteria.To, err = time.Parse(model.DateLayout, r.To)
+		if err != nil {
+			return domain.BloodPressureDiaryCriteria{}, err
+		}
+	}
+
+	return criteria, nil
+}
+
+type getUserBloodPressureDiaryResponse struct {
+	Measurements []*domain.BloodPressureMeasurement `json:"measurements"`
+}
+
+func (r *Router) getUserBloodPressureDiary(c echo.Context) error {
+	var reqData getBloodPressureDiaryRequest
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	criteria, err := reqData.criteria(c.Get(ctxKeyUserID).(uint64))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	measurements, err := r.services.BloodPressure().FindAll(criteria)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidBloodPressureDiaryCriteria) {
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidBloodPressureDiaryCriteria))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, &getUserBloodPressureDiaryResponse{
+		Measurements: measurements,
+	})
+}
+
+func (r *Router) createBloodPressureMeasurement(c echo.Context) error {
+	var reqData domain.BloodPressureMeasurement
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	reqData.UserID = c.Get(ctxKeyUserID).(uint64)
+
+	if err := r.services.BloodPressure().Create(reqData); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidSystolicPressure):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidSystolicPressure))
+		case errors.Is(err, domain.ErrInvalidDiastolicPressure):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidDiastolicPressure))
+		case errors.Is(err, domain.ErrInvalidPulse):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidPulse))
+		case errors.Is(err, domain.ErrInvalidArm):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidArm))
+		case errors.Is(err, domain.ErrInvalidPosition):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidPosition))
+		case errors.Is(err, domain.ErrInvalidTimeOfDay):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidTimeOfDay))
+		case errors.Is(err, domain.ErrInvalidBloodPressureData):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidRequestData))
+		default:
+			return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+		}
+	}
+
+	return c.JSON(http.StatusOK, newResult(resultCreated))
+}
+
+func (r *Router) deleteBloodPressureMeasurement(c echo.Context) error {
+	measurementID, err := strconv.ParseUint(c.Param(bloodPressureMeasurementIDPathKey), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	if err = r.services.BloodPressure().Delete(measurementID, userID); err != nil {
+		if errors.Is(err, domain.ErrBloodPressureMeasurementNotFound) {
+			return c.JSON(http.StatusNotFound, newError(c, err, errorBloodPressureMeasurementNotFound))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, newResult(resultDeleted))
+}
+
+type getUserBloodPressureWeeklyAveragesResponse struct {
+	Averages []*domain.BloodPressureWeeklyAverage `json:"averages"`
+}
+
+func (r *Router) getUserBloodPressureWeeklyAverages(c echo.Context) error {
+	var reqData getBloodPressureDiaryRequest
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	criteria, err := reqData.criteria(c.Get(ctxKeyUserID).(uint64))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	averages, err := r.services.BloodPressure().WeeklyAverages(criteria)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidBloodPressureDiaryCriteria) {
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidBloodPressureDiaryCriteria))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, &getUserBloodPressureWeeklyAveragesResponse{
+		Averages: averages,
+	})
+}
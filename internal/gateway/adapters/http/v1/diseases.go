@@ -13,6 +13,8 @@ func (r *Router) initDiseasesRoutes(customerAPI *echo.Group) {
 	{
 		diseases.GET("/info", r.getDiseasesInfo)
 		diseases.PUT("/edit", r.editDiseasesInfo)
+		diseases.GET("/revisions", r.getDiseasesRevisions)
+		diseases.GET("/revisions/diff", r.getDiseasesRevisionsDiff)
 	}
 }
 
@@ -0,0 +1,156 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+const glucoseMeasurementIDPathKey = "measurementID"
+
+// possible glycaemic diary errors designations
+const (
+	errorGlucoseMeasurementNotFound    = "GlucoseMeasurementNotFound"
+	errorInvalidGlucoseMeasurementType = "InvalidGlucoseMeasurementType"
+	errorInvalidGlucoseValue           = "InvalidGlucoseValue"
+	errorInvalidGlucoseDiaryCriteria   = "InvalidGlucoseDiaryCriteria"
+)
+
+func (r *Router) initGlucoseRoutes(customerAPI *echo.Group) {
+	glucose := customerAPI.Group("/glucose", r.identifyUser, r.verifyCustomer)
+	{
+		glucose.GET("", r.getUserGlucoseDiary)
+		glucose.POST("", r.createGlucoseMeasurement)
+		glucose.DELETE(fmt.Sprintf("/:%v", glucoseMeasurementIDPathKey), r.deleteGlucoseMeasurement)
+		glucose.GET("/control", r.getUserGlycaemicControl)
+	}
+}
+
+// getGlucoseDiaryRequest represents the listing parameters of the user glycaemic diary.
+type getGlucoseDiaryRequest struct {
+	Type string `query:"type"`
+	From string `query:"from"` // DateLayout
+	To   string `query:"to"`   // DateLayout
+}
+
+func (r getGlucoseDiaryRequest) criteria(userID uint64) (domain.GlucoseDiaryCriteria, error) {
+	criteria := domain.GlucoseDiaryCriteria{
+		UserID: userID,
+		Type:   r.Type,
+	}
+
+	var err error
+	if r.From != "" {
+		criteria.From, err = time.Parse(model.DateLayout, r.From)
+		if err != nil {
+			return domain.GlucoseDiaryCriteria{}, err
+		}
+	}
+	if r.To != "" {
+		criteria.To, err = time.Parse(model.DateLayout, r.To)
+		if err != nil {
+			return domain.GlucoseDiaryCriteria{}, err
+		}
+	}
+
+	return criteria, nil
+}
+
+type getUserGlucoseDiaryResponse struct {
+	Measurements []*domain.GlucoseMeasurement `json:"measurements"`
+}
+
+func (r *Router) getUserGlucoseDiary(c echo.Context) error {
+	var reqData getGlucoseDiaryRequest
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	criteria, err := reqData.criteria(userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	measurements, err := r.services.Glucose().FindAll(criteria)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidGlucoseDiaryCriteria) {
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidGlucoseDiaryCriteria))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	preferences, err := r.services.Preferences().Get(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	for _, measurement := range measurements {
+		measurement.ConvertUnits(preferences.UnitSystem)
+	}
+
+	return c.JSON(http.StatusOK, &getUserGlucoseDiaryResponse{
+		Measurements: measurements,
+	})
+}
+
+func (r *Router) createGlucoseMeasurement(c echo.Context) error {
+	var reqData domain.GlucoseMeasurement
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	reqData.UserID = c.Get(ctxKeyUserID).(uint64)
+
+	if err := r.services.Glucose().Create(reqData); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidGlucoseMeasurementType):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidGlucoseMeasurementType))
+		case errors.Is(err, domain.ErrInvalidGlucoseValue):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidGlucoseValue))
+		case errors.Is(err, domain.ErrInvalidUnits):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidUnits))
+		default:
+			return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+		}
+	}
+
+	return c.JSON(http.StatusOK, newResult(resultCreated))
+}
+
+func (r *Router) deleteGlucoseMeasurement(c echo.Context) error {
+	measurementID, err := strconv.ParseUint(c.Param(glucoseMeasurementIDPathKey), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	if err = r.services.Glucose().Delete(measurementID, userID); err != nil {
+		if errors.Is(err, domain.ErrGlucoseMeasurementNotFound) {
+			return c.JSON(http.StatusNotFound, newError(c, err, errorGlucoseMeasurementNotFound))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, newResult(resultDeleted))
+}
+
+func (r *Router) getUserGlycaemicControl(c echo.Context) error {
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	control, err := r.services.Glucose().Control(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, control)
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (r *Router) initHypertensionRoutes(customerAPI *echo.Group) {
+	customerAPI.GET("/hypertension", r.getUserHypertensionHistory, r.identifyUser, r.verifyCustomer)
+}
+
+func (r *Router) getUserHypertensionHistory(c echo.Context) error {
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	history, err := r.services.Hypertension().History(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, history)
+}
@@ -0,0 +1,180 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+)
+
+const labTestCodePathKey = "labTestCode"
+
+const queryParamTestCode = "testCode"
+
+// possible lab tests and lab results errors designations
+const (
+	errorLabTestNotFound       = "LabTestNotFound"
+	errorLabTestAlreadyExists  = "LabTestAlreadyExists"
+	errorLabTestBuiltIn        = "LabTestBuiltIn"
+	errorLabTestInUse          = "LabTestInUse"
+	errorInvalidLabTestCode    = "InvalidLabTestCode"
+	errorInvalidLabTestName    = "InvalidLabTestName"
+	errorInvalidLabTestRange   = "InvalidLabTestRange"
+	errorUnknownLabTest        = "UnknownLabTest"
+	errorInvalidLabResultValue = "InvalidLabResultValue"
+	errorInvalidLabResultsData = "InvalidLabResultsData"
+)
+
+func (r *Router) initLabRoutes(customerAPI *echo.Group) {
+	customerAPI.GET("/labTests", r.getLabTests, r.identifyUser, r.verifyCustomer)
+
+	labResults := customerAPI.Group("/labResults", r.identifyUser, r.verifyCustomer)
+	{
+		labResults.GET("", r.getUserLabResults)
+		labResults.POST("", r.createLabResults)
+	}
+}
+
+func (r *Router) initLabTestsRoutes(moderatorAPI *echo.Group) {
+	labTests := moderatorAPI.Group("/labTests", r.identifyUser, r.verifyModerator)
+	{
+		labTests.GET("", r.getLabTests)
+		labTests.POST("", r.createLabTest)
+		labTests.PUT(fmt.Sprintf("/:%v", labTestCodePathKey), r.updateLabTest)
+		labTests.DELETE(fmt.Sprintf("/:%v", labTestCodePathKey), r.deleteLabTest)
+	}
+}
+
+type getLabTestsResponse struct {
+	LabTests []*domain.LabTest `json:"labTests"`
+}
+
+func (r *Router) getLabTests(c echo.Context) error {
+	labTests, err := r.services.LabTests().FindAll()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, &getLabTestsResponse{
+		LabTests: labTests,
+	})
+}
+
+func (r *Router) createLabTest(c echo.Context) error {
+	var reqData domain.LabTest
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	if err := r.services.LabTests().Create(reqData); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidLabTestCode):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidLabTestCode))
+		case errors.Is(err, domain.ErrInvalidLabTestName):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidLabTestName))
+		case errors.Is(err, domain.ErrInvalidLabTestRange):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidLabTestRange))
+		case errors.Is(err, domain.ErrInvalidLabTestData):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidRequestData))
+		case errors.Is(err, domain.ErrLabTestAlreadyExists):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorLabTestAlreadyExists))
+		default:
+			return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+		}
+	}
+
+	return c.JSON(http.StatusOK, newResult(resultCreated))
+}
+
+func (r *Router) updateLabTest(c echo.Context) error {
+	var reqData domain.LabTest
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	reqData.Code = c.Param(labTestCodePathKey)
+
+	if err := r.services.LabTests().Update(reqData); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidLabTestName):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidLabTestName))
+		case errors.Is(err, domain.ErrInvalidLabTestRange):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidLabTestRange))
+		case errors.Is(err, domain.ErrInvalidLabTestData):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidRequestData))
+		case errors.Is(err, domain.ErrLabTestNotFound):
+			return c.JSON(http.StatusNotFound, newError(c, err, errorLabTestNotFound))
+		default:
+			return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+		}
+	}
+
+	return c.JSON(http.StatusOK, newResult(resultUpdated))
+}
+
+func (r *Router) deleteLabTest(c echo.Context) error {
+	if err := r.services.LabTests().Delete(c.Param(labTestCodePathKey)); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrLabTestNotFound):
+			return c.JSON(http.StatusNotFound, newError(c, err, errorLabTestNotFound))
+		case errors.Is(err, domain.ErrLabTestBuiltIn):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorLabTestBuiltIn))
+		case errors.Is(err, domain.ErrLabTestInUse):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorLabTestInUse))
+		default:
+			return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+		}
+	}
+
+	return c.JSON(http.StatusOK, newResult(resultDeleted))
+}
+
+type getUserLabResultsResponse struct {
+	LabResults []*domain.LabResult `json:"labResults"`
+}
+
+func (r *Router) getUserLabResults(c echo.Context) error {
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	labResults, err := r.services.LabResults().FindAll(userID, c.Request().URL.Query().Get(queryParamTestCode))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, &getUserLabResultsResponse{
+		LabResults: labResults,
+	})
+}
+
+type createLabResultsRequest struct {
+	LabResults []*domain.LabResult `json:"labResults"`
+}
+
+func (r *Router) createLabResults(c echo.Context) error {
+	var reqData createLabResultsRequest
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	if err := r.services.LabResults().Create(userID, reqData.LabResults); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnknownLabTest):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorUnknownLabTest))
+		case errors.Is(err, domain.ErrInvalidUnits):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidUnits))
+		case errors.Is(err, domain.ErrInvalidLabResultValue):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidLabResultValue))
+		case errors.Is(err, domain.ErrInvalidLabResultsData):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidLabResultsData))
+		default:
+			return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+		}
+	}
+
+	return c.JSON(http.StatusOK, newResult(resultCreated))
+}
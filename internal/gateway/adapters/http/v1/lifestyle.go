@@ -13,6 +13,8 @@ func (r *Router) initLifestylesRoutes(customerAPI *echo.Group) {
 	{
 		lifestyle.GET("/info", r.getLifestyleInfo)
 		lifestyle.PUT("/edit", r.editLifestyleInfo)
+		lifestyle.GET("/revisions", r.getLifestyleRevisions)
+		lifestyle.GET("/revisions/diff", r.getLifestyleRevisionsDiff)
 	}
 }
 
@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+)
+
+func (r *Router) initLipidManagementRoutes(customerAPI *echo.Group) {
+	customerAPI.GET("/lipidManagement", r.getUserLipidManagement, r.identifyUser, r.verifyCustomer)
+}
+
+func (r *Router) getUserLipidManagement(c echo.Context) error {
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	lipidManagement, err := r.services.LipidManagement().Get(userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotEnoughDataToClassifyRisk) {
+			return c.JSON(http.StatusUnprocessableEntity, newError(c, err, errorNotEnoughInformation))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, lipidManagement)
+}
@@ -0,0 +1,262 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+const (
+	medicationIDPathKey       = "medicationID"
+	medicationIntakeIDPathKey = "intakeID"
+)
+
+// possible medications errors designations
+const (
+	errorMedicationNotFound           = "MedicationNotFound"
+	errorInvalidMedicationName        = "InvalidMedicationName"
+	errorInvalidMedicationClass       = "InvalidMedicationClass"
+	errorInvalidMedicationDose        = "InvalidMedicationDose"
+	errorInvalidMedicationDosesPerDay = "InvalidMedicationDosesPerDay"
+	errorInvalidMedicationDates       = "InvalidMedicationDates"
+	errorInvalidMedicationIntake      = "InvalidMedicationIntake"
+	errorMedicationIntakeNotFound     = "MedicationIntakeNotFound"
+	errorInvalidAdherenceCriteria     = "InvalidAdherenceCriteria"
+)
+
+func (r *Router) initMedicationsRoutes(customerAPI *echo.Group) {
+	medications := customerAPI.Group("/medications", r.identifyUser, r.verifyCustomer)
+	{
+		medications.GET("", r.getUserMedications)
+		medications.POST("", r.createMedication)
+		medications.PUT(fmt.Sprintf("/:%v", medicationIDPathKey), r.updateMedication)
+		medications.DELETE(fmt.Sprintf("/:%v", medicationIDPathKey), r.deleteMedication)
+		medications.POST(fmt.Sprintf("/:%v/intakes", medicationIDPathKey), r.createMedicationIntake)
+
+		medications.GET("/intakes", r.getUserMedicationIntakes)
+		medications.DELETE(fmt.Sprintf("/intakes/:%v", medicationIntakeIDPathKey), r.deleteMedicationIntake)
+
+		medications.GET("/adherence", r.getUserMedicationAdherence)
+	}
+}
+
+// medicationsPeriodRequest represents the dates range of the user medications data.
+type medicationsPeriodRequest struct {
+	From string `query:"from"` // DateLayout
+	To   string `query:"to"`   // DateLayout
+}
+
+func (r medicationsPeriodRequest) period() (from, to time.Time, err error) {
+	if r.From != "" {
+		from, err = time.Parse(model.DateLayout, r.From)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+	if r.To != "" {
+		to, err = time.Parse(model.DateLayout, r.To)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+	return from, to, nil
+}
+
+type getUserMedicationsResponse struct {
+	Medications []*domain.Medication `json:"medications"`
+}
+
+func (r *Router) getUserMedications(c echo.Context) error {
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	medications, err := r.services.Medications().FindAll(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, &getUserMedicationsResponse{
+		Medications: medications,
+	})
+}
+
+func (r *Router) createMedication(c echo.Context) error {
+	var reqData domain.Medication
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	reqData.UserID = c.Get(ctxKeyUserID).(uint64)
+
+	if err := r.services.Medications().Create(reqData); err != nil {
+		return r.medicationError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, newResult(resultCreated))
+}
+
+func (r *Router) updateMedication(c echo.Context) error {
+	medicationID, err := strconv.ParseUint(c.Param(medicationIDPathKey), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	var reqData domain.Medication
+	if err = c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	reqData.ID = medicationID
+	reqData.UserID = c.Get(ctxKeyUserID).(uint64)
+
+	if err = r.services.Medications().Update(reqData); err != nil {
+		return r.medicationError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, newResult(resultUpdated))
+}
+
+// medicationError responds with the error of the medication creation or update.
+func (r *Router) medicationError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidMedicationName):
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidMedicationName))
+	case errors.Is(err, domain.ErrInvalidMedicationClass):
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidMedicationClass))
+	case errors.Is(err, domain.ErrInvalidMedicationDose):
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidMedicationDose))
+	case errors.Is(err, domain.ErrInvalidMedicationDosesPerDay):
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidMedicationDosesPerDay))
+	case errors.Is(err, domain.ErrInvalidMedicationDates):
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidMedicationDates))
+	case errors.Is(err, domain.ErrInvalidMedicationData):
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidRequestData))
+	case errors.Is(err, domain.ErrMedicationNotFound):
+		return c.JSON(http.StatusNotFound, newError(c, err, errorMedicationNotFound))
+	default:
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+}
+
+func (r *Router) deleteMedication(c echo.Context) error {
+	medicationID, err := strconv.ParseUint(c.Param(medicationIDPathKey), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	if err = r.services.Medications().Delete(medicationID, userID); err != nil {
+		if errors.Is(err, domain.ErrMedicationNotFound) {
+			return c.JSON(http.StatusNotFound, newError(c, err, errorMedicationNotFound))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, newResult(resultDeleted))
+}
+
+func (r *Router) createMedicationIntake(c echo.Context) error {
+	medicationID, err := strconv.ParseUint(c.Param(medicationIDPathKey), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	var reqData domain.MedicationIntake
+	if err = c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	reqData.MedicationID = medicationID
+	reqData.UserID = c.Get(ctxKeyUserID).(uint64)
+
+	if err = r.services.Medications().CreateIntake(reqData); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrMedicationNotFound):
+			return c.JSON(http.StatusNotFound, newError(c, err, errorMedicationNotFound))
+		case errors.Is(err, domain.ErrInvalidMedicationIntake):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidMedicationIntake))
+		default:
+			return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+		}
+	}
+
+	return c.JSON(http.StatusOK, newResult(resultCreated))
+}
+
+type getUserMedicationIntakesResponse struct {
+	Intakes []*domain.MedicationIntake `json:"intakes"`
+}
+
+func (r *Router) getUserMedicationIntakes(c echo.Context) error {
+	var reqData medicationsPeriodRequest
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	from, to, err := reqData.period()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	intakes, err := r.services.Medications().FindIntakes(userID, from, to)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, &getUserMedicationIntakesResponse{
+		Intakes: intakes,
+	})
+}
+
+func (r *Router) deleteMedicationIntake(c echo.Context) error {
+	intakeID, err := strconv.ParseUint(c.Param(medicationIntakeIDPathKey), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	if err = r.services.Medications().DeleteIntake(intakeID, userID); err != nil {
+		if errors.Is(err, domain.ErrMedicationIntakeNotFound) {
+			return c.JSON(http.StatusNotFound, newError(c, err, errorMedicationIntakeNotFound))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, newResult(resultDeleted))
+}
+
+func (r *Router) getUserMedicationAdherence(c echo.Context) error {
+	var reqData medicationsPeriodRequest
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	from, to, err := reqData.period()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	adherence, err := r.services.Medications().Adherence(domain.MedicationAdherenceCriteria{
+		UserID: c.Get(ctxKeyUserID).(uint64),
+		From:   from,
+		To:     to,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidAdherenceCriteria) {
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidAdherenceCriteria))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, adherence)
+}
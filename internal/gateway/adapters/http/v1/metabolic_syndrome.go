@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (r *Router) initMetabolicSyndromeRoutes(customerAPI *echo.Group) {
+	metabolicSyndrome := customerAPI.Group("/metabolicSyndrome", r.identifyUser, r.verifyCustomer)
+	{
+		metabolicSyndrome.GET("", r.evaluateUserMetabolicSyndrome)
+		metabolicSyndrome.POST("", r.screenUserMetabolicSyndrome)
+		metabolicSyndrome.GET("/history", r.getUserMetabolicSyndromeHistory)
+	}
+}
+
+func (r *Router) evaluateUserMetabolicSyndrome(c echo.Context) error {
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	screening, err := r.services.MetabolicSyndrome().Evaluate(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, screening)
+}
+
+func (r *Router) screenUserMetabolicSyndrome(c echo.Context) error {
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	screening, err := r.services.MetabolicSyndrome().Screen(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, screening)
+}
+
+func (r *Router) getUserMetabolicSyndromeHistory(c echo.Context) error {
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	screenings, err := r.services.MetabolicSyndrome().History(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, screenings)
+}
@@ -7,9 +7,16 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
 	"github.com/cardio-analyst/backend/internal/pkg/model"
 )
 
+// possible units errors designations
+const (
+	errorInvalidUnits      = "InvalidUnits"
+	errorInvalidUnitSystem = "InvalidUnitSystem"
+)
+
 func (r *Router) initProfileRoutes() {
 	profile := r.api.Group(fmt.Sprintf("/:%v/profile", userRolePathKey), r.identifyUser, r.parseUserRole)
 	{
@@ -30,11 +37,25 @@ func (r *Router) getProfileInfo(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
 	}
 
-	return c.JSON(http.StatusOK, &user)
+	preferences, err := r.services.Preferences().Get(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, &profileInfo{
+		User:       user,
+		UnitSystem: preferences.UnitSystem,
+	})
+}
+
+// profileInfo represents the user profile stored by the auth service extended with the user preferences.
+type profileInfo struct {
+	model.User
+	UnitSystem string `json:"unitSystem,omitempty"`
 }
 
 func (r *Router) editProfileInfo(c echo.Context) error {
-	var reqData model.User
+	var reqData profileInfo
 	if err := c.Bind(&reqData); err != nil {
 		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
 	}
@@ -42,7 +63,19 @@ func (r *Router) editProfileInfo(c echo.Context) error {
 	reqData.ID = c.Get(ctxKeyUserID).(uint64)
 	reqData.Role = c.Get(ctxKeyUserRole).(model.UserRole)
 
-	if err := r.services.User().Update(c.Request().Context(), reqData); err != nil {
+	preferences := domain.Preferences{
+		UserID:     reqData.ID,
+		UnitSystem: reqData.UnitSystem,
+	}
+
+	// the preferences are saved only after the user data is accepted, so they are validated beforehand
+	if reqData.UnitSystem != "" {
+		if err := preferences.Validate(); err != nil {
+			return r.preferencesError(c, err)
+		}
+	}
+
+	if err := r.services.User().Update(c.Request().Context(), reqData.User); err != nil {
 		switch {
 		case errors.Is(err, model.ErrInvalidFirstName):
 			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidFirstName))
@@ -69,5 +102,21 @@ func (r *Router) editProfileInfo(c echo.Context) error {
 		}
 	}
 
+	if reqData.UnitSystem != "" {
+		if err := r.services.Preferences().Update(preferences); err != nil {
+			return r.preferencesError(c, err)
+		}
+	}
+
 	return c.JSON(http.StatusOK, newResult(resultUpdated))
 }
+
+// preferencesError responds with the error of the preferences validation or update.
+func (r *Router) preferencesError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidUnitSystem):
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidUnitSystem))
+	default:
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+}
@@ -1,32 +1,48 @@
 package v1
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
 	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
 )
 
+// possible questionnaire errors designations
+const (
+	errorInvalidAnginaRoseAnswers = "InvalidAnginaRoseAnswers"
+)
+
+// initQuestionnaireRoutes registers the routes of the questionnaires which are not defined declaratively, see
+// common.QuestionnaireCodeAnginaRose and common.QuestionnaireCodeTreatmentAdherence.
 func (r *Router) initQuestionnaireRoutes(customerAPI *echo.Group) {
 	tests := customerAPI.Group("/tests", r.identifyUser, r.verifyCustomer)
 	{
-		angina := tests.Group("/angina-rose")
+		angina := tests.Group("/" + common.QuestionnaireCodeAnginaRose)
 		{
 			angina.GET("/info", r.anginaRoseInfo)
 			angina.PUT("/edit", r.anginaRoseEdit)
+			angina.GET("/history", r.anginaRoseHistory)
 		}
 
-		adherence := tests.Group("/treatment-adherence")
+		adherence := tests.Group("/" + common.QuestionnaireCodeTreatmentAdherence)
 		{
 			adherence.GET("/info", r.treatmentAdherenceInfo)
 			adherence.PUT("/edit", r.treatmentAdherenceEdit)
 		}
+
+		tests.GET("/revisions", r.getQuestionnaireRevisions)
+		tests.GET("/revisions/diff", r.getQuestionnaireRevisionsDiff)
 	}
 }
 
 type anginaRoseInfoResponse struct {
-	AnginaScore int8 `json:"anginaScore" db:"angina_score"`
+	// AnginaScore is the score of the latest result, see domain.AnginaRoseResult Score, -1 if the questionnaire has
+	// not been passed yet
+	AnginaScore int8                     `json:"anginaScore"`
+	Result      *domain.AnginaRoseResult `json:"result,omitempty"`
 }
 
 func (r *Router) anginaRoseInfo(c echo.Context) error {
@@ -37,30 +53,68 @@ func (r *Router) anginaRoseInfo(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
 	}
 
-	return c.JSON(http.StatusOK, &anginaRoseInfoResponse{
+	results, err := r.services.Questionnaire().AnginaRoseResults(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	response := &anginaRoseInfoResponse{
 		AnginaScore: questionnaire.AnginaScore,
-	})
+	}
+	if len(results) > 0 {
+		response.Result = results[0]
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+type anginaRoseEditRequest struct {
+	Answers domain.AnginaRoseAnswers `json:"answers"`
 }
 
 func (r *Router) anginaRoseEdit(c echo.Context) error {
-	var reqData domain.Questionnaire
+	var reqData anginaRoseEditRequest
 	if err := c.Bind(&reqData); err != nil {
 		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
 	}
 
-	reqData.UserID = c.Get(ctxKeyUserID).(uint64)
+	userID := c.Get(ctxKeyUserID).(uint64)
 
-	if err := r.services.Questionnaire().UpdateAnginaRose(reqData); err != nil {
+	result, err := r.services.Questionnaire().UpdateAnginaRose(userID, reqData.Answers)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidAnginaRoseAnswers) {
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidAnginaRoseAnswers))
+		}
 		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
 	}
 
-	return c.JSON(http.StatusOK, newResult(resultUpdated))
+	return c.JSON(http.StatusOK, result)
+}
+
+type anginaRoseHistoryResponse struct {
+	Results []*domain.AnginaRoseResult `json:"results"`
+}
+
+func (r *Router) anginaRoseHistory(c echo.Context) error {
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	results, err := r.services.Questionnaire().AnginaRoseResults(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, &anginaRoseHistoryResponse{
+		Results: results,
+	})
 }
 
 type treatmentAdherenceInfoResponse struct {
 	AdherenceDrugTherapy    float64 `json:"adherenceDrugTherapy"`
 	AdherenceMedicalSupport float64 `json:"adherenceMedicalSupport"`
 	AdherenceLifestyleMod   float64 `json:"adherenceLifestyleMod"`
+	// AdherenceDrugTherapyObjective is the percentage of the medication doses marked as taken, see
+	// domain.MedicationAdherence
+	AdherenceDrugTherapyObjective *float64 `json:"adherenceDrugTherapyObjective"`
 }
 
 func (r *Router) treatmentAdherenceInfo(c echo.Context) error {
@@ -71,10 +125,18 @@ func (r *Router) treatmentAdherenceInfo(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
 	}
 
+	adherence, err := r.services.Medications().Adherence(domain.MedicationAdherenceCriteria{
+		UserID: userID,
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
 	return c.JSON(http.StatusOK, &treatmentAdherenceInfoResponse{
-		AdherenceDrugTherapy:    questionnaire.AdherenceDrugTherapy,
-		AdherenceMedicalSupport: questionnaire.AdherenceMedicalSupport,
-		AdherenceLifestyleMod:   questionnaire.AdherenceLifestyleMod,
+		AdherenceDrugTherapy:          questionnaire.AdherenceDrugTherapy,
+		AdherenceMedicalSupport:       questionnaire.AdherenceMedicalSupport,
+		AdherenceLifestyleMod:         questionnaire.AdherenceLifestyleMod,
+		AdherenceDrugTherapyObjective: adherence.Percentage,
 	})
 }
 
@@ -17,6 +17,7 @@ import (
 // possible recommendations errors designations
 const (
 	errorNotEnoughDataToCompileReport = "NotEnoughDataToCompileReport"
+	errorInvalidRecommendationsLimit  = "InvalidRecommendationsLimit"
 )
 
 var errNoOneToSendReport = errors.New("there is no one to send report to")
@@ -29,15 +30,27 @@ func (r *Router) initRecommendationsRoutes(customerAPI *echo.Group) {
 	}
 }
 
+type getRecommendationsRequest struct {
+	Limit int `query:"limit"`
+}
+
 type getRecommendationsResponse struct {
 	Recommendations []*domain.Recommendation `json:"recommendations"`
 }
 
 func (r *Router) getRecommendations(c echo.Context) error {
+	var reqData getRecommendationsRequest
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
 	userID := c.Get(ctxKeyUserID).(uint64)
 
-	recommendations, err := r.services.Recommendations().GetRecommendations(userID)
+	recommendations, err := r.services.Recommendations().GetRecommendations(userID, reqData.Limit)
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidRecommendationsLimit) {
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidRecommendationsLimit))
+		}
 		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
 	}
 
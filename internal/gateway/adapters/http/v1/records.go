@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"time"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+const errorInvalidRecordsCriteria = "InvalidRecordsCriteria"
+
+// getRecordsRequest represents the listing parameters of the user records (analyses, basic indicators).
+type getRecordsRequest struct {
+	From   string `query:"from"` // DateLayout
+	To     string `query:"to"`   // DateLayout
+	Cursor uint64 `query:"cursor"`
+	Limit  int64  `query:"limit"`
+}
+
+func (r getRecordsRequest) criteria(userID uint64) (domain.RecordsCriteria, error) {
+	criteria := domain.RecordsCriteria{
+		UserID: userID,
+		Cursor: r.Cursor,
+		Limit:  r.Limit,
+	}
+
+	var err error
+	if r.From != "" {
+		criteria.From, err = time.Parse(model.DateLayout, r.From)
+		if err != nil {
+			return domain.RecordsCriteria{}, err
+		}
+	}
+	if r.To != "" {
+		criteria.To, err = time.Parse(model.DateLayout, r.To)
+		if err != nil {
+			return domain.RecordsCriteria{}, err
+		}
+	}
+
+	return criteria, nil
+}
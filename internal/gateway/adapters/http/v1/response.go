@@ -10,6 +10,7 @@ const (
 	resultRegistered = "Registered"
 	resultCreated    = "Created"
 	resultUpdated    = "Updated"
+	resultDeleted    = "Deleted"
 	resultSent       = "Sent"
 )
 
@@ -59,6 +60,9 @@ var errorDescriptions = map[string]string{
 	errorInvalidTotalCholesterolLevel:        "Некорректное значение общего холестерина",
 	errorInvalidCVEventsRiskValue:            "Некорректное значение риска сердечно-сосудистых заболеваний",
 	errorInvalidIdealCardiovascularAgesRange: "Некорректное значение идеального возраста сердечно-сосудистой системы",
+	// units
+	errorInvalidUnits:      "Некорректные единицы измерения",
+	errorInvalidUnitSystem: "Некорректная система единиц измерения",
 	// auth, profile
 	errorInvalidFirstName: "Некорректное значение имени",
 	errorInvalidLastName:  "Некорректное значение фамилии",
@@ -68,11 +72,68 @@ var errorDescriptions = map[string]string{
 	errorInvalidEmail:     "Некорректное значение электронной почты",
 	errorInvalidPassword:  "Некорректное значение пароля",
 	// score
-	errorInvalidAge: "Некорректное значение возраста",
+	errorInvalidAge:            "Некорректное значение возраста",
+	errorInvalidRiskScope:      "Некорректное значение шкалы риска SCORE",
+	errorInvalidScoreScenarios: "Некорректный набор сценариев",
 	// recommendations
 	errorNotEnoughDataToCompileReport: "Недостаточно данных в профиле для формирования и отправки отчёта",
+	errorInvalidRecommendationsLimit:  "Некорректное количество рекомендаций",
 	// feedback
 	errorFeedbackNotFound: "Отзыв не найден",
+
+	errorInvalidRecordsCriteria: "Некорректные параметры выборки записей",
+
+	errorLabTestNotFound:       "Лабораторный показатель не найден",
+	errorLabTestAlreadyExists:  "Лабораторный показатель с таким кодом уже существует",
+	errorLabTestBuiltIn:        "Встроенный лабораторный показатель не может быть удалён",
+	errorLabTestInUse:          "Лабораторный показатель не может быть удалён, так как по нему есть результаты",
+	errorInvalidLabTestCode:    "Некорректный код лабораторного показателя",
+	errorInvalidLabTestName:    "Некорректное название лабораторного показателя",
+	errorInvalidLabTestRange:   "Некорректный диапазон допустимых значений лабораторного показателя",
+	errorUnknownLabTest:        "Неизвестный лабораторный показатель",
+	errorInvalidLabResultValue: "Значение результата исследования вне допустимого диапазона",
+	errorInvalidLabResultsData: "Некорректный набор результатов исследований",
+
+	errorInvalidTrendPeriod:    "Некорректный период агрегации, допустимые значения: day, week, month",
+	errorInvalidTrendMetrics:   "Некорректный набор показателей",
+	errorInvalidTrendsCriteria: "Некорректные параметры выборки динамики показателей",
+
+	errorBloodPressureMeasurementNotFound:  "Измерение артериального давления не найдено",
+	errorInvalidSystolicPressure:           "Некорректное значение систолического давления",
+	errorInvalidDiastolicPressure:          "Некорректное значение диастолического давления",
+	errorInvalidPulse:                      "Некорректное значение пульса",
+	errorInvalidArm:                        "Некорректное значение руки измерения",
+	errorInvalidPosition:                   "Некорректное значение положения тела при измерении",
+	errorInvalidTimeOfDay:                  "Некорректное значение времени суток измерения",
+	errorInvalidBloodPressureDiaryCriteria: "Некорректные параметры выборки дневника давления",
+
+	errorGlucoseMeasurementNotFound:    "Измерение гликемии не найдено",
+	errorInvalidGlucoseMeasurementType: "Некорректный тип измерения гликемии, допустимые значения: fastingGlucose, postprandialGlucose, glycatedHemoglobin",
+	errorInvalidGlucoseValue:           "Значение измерения гликемии вне допустимого диапазона",
+	errorInvalidGlucoseDiaryCriteria:   "Некорректные параметры выборки дневника гликемии",
+
+	errorActivityEntryNotFound:      "Запись о физической активности не найдена",
+	errorInvalidActivityType:        "Некорректный вид физической активности",
+	errorInvalidActivityIntensity:   "Некорректная интенсивность физической активности, допустимые значения: light, moderate, vigorous",
+	errorInvalidActivityDuration:    "Некорректная продолжительность физической активности, допустимые значения: от 1 до 1440 минут",
+	errorInvalidActivityLogCriteria: "Некорректные параметры выборки журнала физической активности",
+
+	errorMedicationNotFound:           "Препарат не найден",
+	errorInvalidMedicationName:        "Некорректное название препарата",
+	errorInvalidMedicationClass:       "Некорректная группа препарата",
+	errorInvalidMedicationDose:        "Некорректная дозировка препарата",
+	errorInvalidMedicationDosesPerDay: "Некорректное количество приёмов препарата в день",
+	errorInvalidMedicationDates:       "Некорректные даты начала и окончания приёма препарата",
+	errorInvalidMedicationIntake:      "Приём препарата вне периода его назначения или в будущем",
+	errorMedicationIntakeNotFound:     "Отметка о приёме препарата не найдена",
+	errorInvalidAdherenceCriteria:     "Некорректный период расчёта приверженности лечению",
+
+	errorInvalidAnginaRoseAnswers: "Некорректный набор ответов опросника Роуза",
+
+	errorQuestionnaireNotFound:       "Опросник не найден",
+	errorInvalidQuestionnaireAnswers: "Некорректный набор ответов опросника",
+
+	errorRevisionNotFound: "Версия записи не найдена",
 }
 
 type response struct {
@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+)
+
+// possible revisions errors designations
+const (
+	errorRevisionNotFound = "RevisionNotFound"
+)
+
+type getRevisionsResponse struct {
+	Revisions []*domain.Revision `json:"revisions"`
+}
+
+// getRevisions responds with the revisions of the user record found by the find function.
+func (r *Router) getRevisions(c echo.Context, find func(userID uint64) ([]*domain.Revision, error)) error {
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	revisions, err := find(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, &getRevisionsResponse{
+		Revisions: revisions,
+	})
+}
+
+type getRevisionsDiffRequest struct {
+	// From and To are the revisions ids, 0 means the revision preceding the to one and the latest revision
+	// respectively
+	From uint64 `query:"from"`
+	To   uint64 `query:"to"`
+}
+
+// getRevisionsDiff responds with the changes of the user record between the revisions found by the diff function.
+func (r *Router) getRevisionsDiff(c echo.Context, diff func(userID, fromID, toID uint64) (*domain.RevisionsDiff, error)) error {
+	var reqData getRevisionsDiffRequest
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	revisionsDiff, err := diff(userID, reqData.From, reqData.To)
+	if err != nil {
+		if errors.Is(err, domain.ErrRevisionNotFound) {
+			return c.JSON(http.StatusNotFound, newError(c, err, errorRevisionNotFound))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, revisionsDiff)
+}
+
+func (r *Router) getDiseasesRevisions(c echo.Context) error {
+	return r.getRevisions(c, r.services.Diseases().Revisions)
+}
+
+func (r *Router) getDiseasesRevisionsDiff(c echo.Context) error {
+	return r.getRevisionsDiff(c, r.services.Diseases().RevisionsDiff)
+}
+
+func (r *Router) getLifestyleRevisions(c echo.Context) error {
+	return r.getRevisions(c, r.services.Lifestyle().Revisions)
+}
+
+func (r *Router) getLifestyleRevisionsDiff(c echo.Context) error {
+	return r.getRevisionsDiff(c, r.services.Lifestyle().RevisionsDiff)
+}
+
+func (r *Router) getQuestionnaireRevisions(c echo.Context) error {
+	return r.getRevisions(c, r.services.Questionnaire().Revisions)
+}
+
+func (r *Router) getQuestionnaireRevisionsDiff(c echo.Context) error {
+	return r.getRevisionsDiff(c, r.services.Questionnaire().RevisionsDiff)
+}
+
+type getUserEvolutionResponse struct {
+	Diseases      []*domain.RevisionEvolution `json:"diseases"`
+	Lifestyle     []*domain.RevisionEvolution `json:"lifestyle"`
+	Questionnaire []*domain.RevisionEvolution `json:"questionnaire"`
+}
+
+func (r *Router) getUserEvolution(c echo.Context) error {
+	userID, err := strconv.ParseUint(c.Param(userIDPathKey), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	var response getUserEvolutionResponse
+
+	if response.Diseases, err = r.services.Diseases().Evolution(userID); err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+	if response.Lifestyle, err = r.services.Lifestyle().Evolution(userID); err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+	if response.Questionnaire, err = r.services.Questionnaire().Evolution(userID); err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, &response)
+}
@@ -61,6 +61,36 @@ func (r *Router) initCustomerRoutes() {
 
 		// /tests/*
 		r.initQuestionnaireRoutes(customerAPI)
+
+		// /tests, /tests/:code/*
+		r.initTestsRoutes(customerAPI)
+
+		// /labTests, /labResults/*
+		r.initLabRoutes(customerAPI)
+
+		// /trends
+		r.initTrendsRoutes(customerAPI)
+
+		// /bloodPressure/*
+		r.initBloodPressureRoutes(customerAPI)
+
+		// /hypertension
+		r.initHypertensionRoutes(customerAPI)
+
+		// /medications/*
+		r.initMedicationsRoutes(customerAPI)
+
+		// /lipidManagement
+		r.initLipidManagementRoutes(customerAPI)
+
+		// /metabolicSyndrome/*
+		r.initMetabolicSyndromeRoutes(customerAPI)
+
+		// /glucose/*
+		r.initGlucoseRoutes(customerAPI)
+
+		// /activity/*
+		r.initActivityRoutes(customerAPI)
 	}
 }
 
@@ -72,6 +102,9 @@ func (r *Router) initModeratorRoutes() {
 
 		// /statistics/*
 		r.initStatisticsRoutes(moderatorAPI)
+
+		// /labTests/*
+		r.initLabTestsRoutes(moderatorAPI)
 	}
 }
 
@@ -3,6 +3,7 @@ package v1
 import (
 	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 
@@ -12,8 +13,10 @@ import (
 
 // possible score errors designations
 const (
-	errorInvalidAge           = "InvalidAge"
-	errorNotEnoughInformation = "NotEnoughInformation"
+	errorInvalidAge            = "InvalidAge"
+	errorInvalidRiskScope      = "InvalidRiskScope"
+	errorInvalidScoreScenarios = "InvalidScoreScenarios"
+	errorNotEnoughInformation  = "NotEnoughInformation"
 )
 
 func (r *Router) initScoreRoutes(customerAPI *echo.Group) {
@@ -21,12 +24,18 @@ func (r *Router) initScoreRoutes(customerAPI *echo.Group) {
 	{
 		score.GET("/cveRisk", r.cveRisk)
 		score.GET("/idealAge", r.idealAge)
+		score.POST("/simulate", r.simulateScore)
+		score.GET("/riskCategory", r.riskCategory)
 	}
 }
 
 type getCVERiskResponse struct {
-	Value uint64 `json:"value"`
-	Scale string `json:"scale"`
+	Value                  float64 `json:"value"`
+	Scale                  string  `json:"scale"`
+	RiskScope              string  `json:"riskScope"`
+	NonHDLCholesterolLevel float64 `json:"nonHdlCholesterolLevel"`
+	// Approximate is true if HDL cholesterol is unknown and total cholesterol is used instead of non-HDL one
+	Approximate bool `json:"approximate"`
 }
 
 func (r *Router) cveRisk(c echo.Context) error {
@@ -47,6 +56,17 @@ func (r *Router) cveRisk(c echo.Context) error {
 	}
 
 	reqData.Age = user.Age()
+	if reqData.RiskScope == "" {
+		reqData.RiskScope = r.services.Score().ResolveRiskScope(user.Region)
+	}
+	if reqData.HDLCholesterolLevel == 0 {
+		var analyses []*domain.Analysis
+		analyses, err = r.services.Analysis().FindAll(userID)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+		}
+		reqData.HDLCholesterolLevel = domain.ExtractHDLCholesterolLevelFrom(analyses)
+	}
 
 	riskValue, scale, err := r.services.Score().GetCVERisk(reqData)
 	if err != nil {
@@ -59,6 +79,10 @@ func (r *Router) cveRisk(c echo.Context) error {
 			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidSBPLevel))
 		case errors.Is(err, domain.ErrInvalidTotalCholesterolLevel):
 			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidTotalCholesterolLevel))
+		case errors.Is(err, domain.ErrInvalidHDLCholesterolLevel):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidHighDensityCholesterol))
+		case errors.Is(err, domain.ErrInvalidRiskScope):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidRiskScope))
 		case errors.Is(err, domain.ErrInvalidScoreData):
 			return c.JSON(http.StatusUnprocessableEntity, newError(c, err, errorNotEnoughInformation))
 		default:
@@ -66,15 +90,26 @@ func (r *Router) cveRisk(c echo.Context) error {
 		}
 	}
 
+	nonHDLCholesterolLevel, approximate := reqData.NonHDLCholesterolLevel()
+
 	return c.JSON(http.StatusOK, &getCVERiskResponse{
-		Value: riskValue,
-		Scale: scale,
+		Value:                  riskValue,
+		Scale:                  scale,
+		RiskScope:              reqData.RiskScope,
+		NonHDLCholesterolLevel: nonHDLCholesterolLevel,
+		Approximate:            approximate,
 	})
 }
 
 type getIdealAgeResponse struct {
-	Value string `json:"value"`
-	Scale string `json:"scale"`
+	// Value is the cardiovascular age as a string, it is kept for the clients saving it to the basic indicators
+	Value       string                           `json:"value"`
+	Age         int                              `json:"age"`
+	RiskValue   float64                          `json:"riskValue"`
+	Scale       string                           `json:"scale"`
+	RiskScope   string                           `json:"riskScope"`
+	Approximate bool                             `json:"approximate"`
+	Factors     []*domain.RiskFactorContribution `json:"factors"`
 }
 
 func (r *Router) idealAge(c echo.Context) error {
@@ -95,8 +130,19 @@ func (r *Router) idealAge(c echo.Context) error {
 	}
 
 	reqData.Age = user.Age()
+	if reqData.RiskScope == "" {
+		reqData.RiskScope = r.services.Score().ResolveRiskScope(user.Region)
+	}
+	if reqData.HDLCholesterolLevel == 0 {
+		var analyses []*domain.Analysis
+		analyses, err = r.services.Analysis().FindAll(userID)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+		}
+		reqData.HDLCholesterolLevel = domain.ExtractHDLCholesterolLevelFrom(analyses)
+	}
 
-	agesRange, scale, err := r.services.Score().GetIdealAge(reqData)
+	cardiovascularAge, err := r.services.Score().GetCardiovascularAge(reqData)
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrInvalidAge):
@@ -107,6 +153,10 @@ func (r *Router) idealAge(c echo.Context) error {
 			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidSBPLevel))
 		case errors.Is(err, domain.ErrInvalidTotalCholesterolLevel):
 			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidTotalCholesterolLevel))
+		case errors.Is(err, domain.ErrInvalidHDLCholesterolLevel):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidHighDensityCholesterol))
+		case errors.Is(err, domain.ErrInvalidRiskScope):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidRiskScope))
 		case errors.Is(err, domain.ErrInvalidScoreData):
 			return c.JSON(http.StatusUnprocessableEntity, newError(c, err, errorNotEnoughInformation))
 		default:
@@ -114,8 +164,103 @@ func (r *Router) idealAge(c echo.Context) error {
 		}
 	}
 
+	_, approximate := reqData.NonHDLCholesterolLevel()
+
 	return c.JSON(http.StatusOK, &getIdealAgeResponse{
-		Value: agesRange,
-		Scale: scale,
+		Value:       strconv.Itoa(cardiovascularAge.Age),
+		Age:         cardiovascularAge.Age,
+		RiskValue:   cardiovascularAge.RiskValue,
+		Scale:       cardiovascularAge.Scale,
+		RiskScope:   reqData.RiskScope,
+		Approximate: approximate,
+		Factors:     cardiovascularAge.Factors,
 	})
 }
+
+type simulateScoreRequest struct {
+	RiskScope string                  `json:"riskScope"` // resolved from user region if not set
+	Scenarios []*domain.ScoreScenario `json:"scenarios"`
+}
+
+func (r *Router) simulateScore(c echo.Context) error {
+	var reqData simulateScoreRequest
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	criteria := model.UserCriteria{
+		ID: userID,
+	}
+
+	user, err := r.services.User().GetOne(c.Request().Context(), criteria)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	basicIndicators, err := r.services.BasicIndicators().FindAll(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	analyses, err := r.services.Analysis().FindAll(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	bloodPressureAverages, err := r.services.BloodPressure().WeeklyAverages(domain.BloodPressureDiaryCriteria{
+		UserID: userID,
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	scoreData := domain.ExtractScoreDataFrom(basicIndicators, bloodPressureAverages)
+	scoreData.Age = user.Age()
+	scoreData.HDLCholesterolLevel = domain.ExtractHDLCholesterolLevelFrom(analyses)
+	scoreData.RiskScope = reqData.RiskScope
+	if scoreData.RiskScope == "" {
+		scoreData.RiskScope = r.services.Score().ResolveRiskScope(user.Region)
+	}
+
+	simulation, err := r.services.Score().Simulate(scoreData, reqData.Scenarios)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidScoreScenarios):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidScoreScenarios))
+		case errors.Is(err, domain.ErrInvalidAge):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidAge))
+		case errors.Is(err, domain.ErrInvalidGender):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidGender))
+		case errors.Is(err, domain.ErrInvalidSBPLevel):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidSBPLevel))
+		case errors.Is(err, domain.ErrInvalidTotalCholesterolLevel):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidTotalCholesterolLevel))
+		case errors.Is(err, domain.ErrInvalidHDLCholesterolLevel):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidHighDensityCholesterol))
+		case errors.Is(err, domain.ErrInvalidRiskScope):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidRiskScope))
+		case errors.Is(err, domain.ErrInvalidScoreData):
+			return c.JSON(http.StatusUnprocessableEntity, newError(c, err, errorNotEnoughInformation))
+		default:
+			return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+		}
+	}
+
+	return c.JSON(http.StatusOK, simulation)
+}
+
+func (r *Router) riskCategory(c echo.Context) error {
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	riskCategory, err := r.services.RiskCategory().Get(userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotEnoughDataToClassifyRisk) {
+			return c.JSON(http.StatusUnprocessableEntity, newError(c, err, errorNotEnoughInformation))
+		}
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	return c.JSON(http.StatusOK, riskCategory)
+}
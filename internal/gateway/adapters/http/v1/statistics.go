@@ -32,11 +32,23 @@ type idealCardiovascularAgesRangeItem struct {
 	Value int64  `json:"value"`
 }
 
+type riskCategoryItem struct {
+	Category string `json:"category"`
+	Value    int64  `json:"value"`
+}
+
+type activityTargetItem struct {
+	Range string `json:"range"`
+	Value int64  `json:"value"`
+}
+
 type getStatisticsResponse struct {
 	UsersByRegions                  []usersByRegionsItem               `json:"usersByRegions"`
 	DiseasesByUsers                 []diseasesItem                     `json:"diseasesByUsers"`
 	SBPByUsers                      []sbpItem                          `json:"sbpByUsers"`
 	CardiovascularAgesRangesByUsers []idealCardiovascularAgesRangeItem `json:"cardiovascularAgesRangesByUsers"`
+	RiskCategoriesByUsers           []riskCategoryItem                 `json:"riskCategoriesByUsers"`
+	ActivityTargetsByUsers          []activityTargetItem               `json:"activityTargetsByUsers"`
 }
 
 func (r *Router) getStatistics(c echo.Context) error {
@@ -138,10 +150,50 @@ func (r *Router) getStatistics(c echo.Context) error {
 		cardiovascularAgesRangesByUsersItems = nil
 	}
 
+	riskCategoriesByUsers, err := r.services.Statistics().RiskCategoriesByUsers(region, regionUsers)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	riskCategoriesByUsersItems := make([]riskCategoryItem, 0, len(riskCategoriesByUsers))
+	for riskCategory, usersNum := range riskCategoriesByUsers {
+		if usersNum == 0 {
+			continue
+		}
+		riskCategoriesByUsersItems = append(riskCategoriesByUsersItems, riskCategoryItem{
+			Category: riskCategory,
+			Value:    usersNum,
+		})
+	}
+	if len(riskCategoriesByUsersItems) == 0 {
+		riskCategoriesByUsersItems = nil
+	}
+
+	activityTargetsByUsers, err := r.services.Statistics().ActivityTargetsByUsers(region, regionUsers)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	activityTargetsByUsersItems := make([]activityTargetItem, 0, len(activityTargetsByUsers))
+	for activityTarget, usersNum := range activityTargetsByUsers {
+		if usersNum == 0 {
+			continue
+		}
+		activityTargetsByUsersItems = append(activityTargetsByUsersItems, activityTargetItem{
+			Range: activityTarget,
+			Value: usersNum,
+		})
+	}
+	if len(activityTargetsByUsersItems) == 0 {
+		activityTargetsByUsersItems = nil
+	}
+
 	return c.JSON(http.StatusOK, &getStatisticsResponse{
 		UsersByRegions:                  usersByRegionsItems,
 		DiseasesByUsers:                 diseasesByUsersItems,
 		SBPByUsers:                      sbpByUsersItems,
 		CardiovascularAgesRangesByUsers: cardiovascularAgesRangesByUsersItems,
+		RiskCategoriesByUsers:           riskCategoriesByUsersItems,
+		ActivityTargetsByUsers:          activityTargetsByUsersItems,
 	})
 }
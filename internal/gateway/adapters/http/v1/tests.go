@@ -0,0 +1,120 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+)
+
+const testCodePathKey = "code"
+
+// possible tests errors designations
+const (
+	errorQuestionnaireNotFound       = "QuestionnaireNotFound"
+	errorInvalidQuestionnaireAnswers = "InvalidQuestionnaireAnswers"
+)
+
+// initTestsRoutes registers the routes of the questionnaires defined in the config. The Rose angina and the treatment
+// adherence questionnaires are served by their dedicated routes, see initQuestionnaireRoutes.
+func (r *Router) initTestsRoutes(customerAPI *echo.Group) {
+	tests := customerAPI.Group("/tests", r.identifyUser, r.verifyCustomer)
+	{
+		tests.GET("", r.getTests)
+		tests.GET(fmt.Sprintf("/:%v", testCodePathKey), r.getTest)
+		tests.PUT(fmt.Sprintf("/:%v", testCodePathKey), r.submitTest)
+		tests.GET(fmt.Sprintf("/:%v/history", testCodePathKey), r.getTestHistory)
+	}
+}
+
+type getTestsResponse struct {
+	Tests []*domain.QuestionnaireDefinition `json:"tests"`
+}
+
+func (r *Router) getTests(c echo.Context) error {
+	return c.JSON(http.StatusOK, &getTestsResponse{
+		Tests: r.services.Tests().FindAll(),
+	})
+}
+
+type getTestResponse struct {
+	Test *domain.QuestionnaireDefinition `json:"test"`
+	// Submission is the latest submission of the user, nil if the test has not been passed yet
+	Submission *domain.QuestionnaireSubmission `json:"submission"`
+}
+
+func (r *Router) getTest(c echo.Context) error {
+	userID := c.Get(ctxKeyUserID).(uint64)
+	code := c.Param(testCodePathKey)
+
+	test, err := r.services.Tests().Get(code)
+	if err != nil {
+		return r.testError(c, err)
+	}
+
+	submissions, err := r.services.Tests().Submissions(userID, code)
+	if err != nil {
+		return r.testError(c, err)
+	}
+
+	response := &getTestResponse{
+		Test: test,
+	}
+	if len(submissions) > 0 {
+		response.Submission = submissions[0]
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+type submitTestRequest struct {
+	Answers domain.QuestionnaireAnswers `json:"answers"`
+}
+
+func (r *Router) submitTest(c echo.Context) error {
+	var reqData submitTestRequest
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	submission, err := r.services.Tests().Submit(userID, c.Param(testCodePathKey), reqData.Answers)
+	if err != nil {
+		return r.testError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, submission)
+}
+
+type getTestHistoryResponse struct {
+	Submissions []*domain.QuestionnaireSubmission `json:"submissions"`
+}
+
+func (r *Router) getTestHistory(c echo.Context) error {
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	submissions, err := r.services.Tests().Submissions(userID, c.Param(testCodePathKey))
+	if err != nil {
+		return r.testError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, &getTestHistoryResponse{
+		Submissions: submissions,
+	})
+}
+
+// testError responds with the error of the test retrieval or submission.
+func (r *Router) testError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrQuestionnaireNotFound):
+		return c.JSON(http.StatusNotFound, newError(c, err, errorQuestionnaireNotFound))
+	case errors.Is(err, domain.ErrInvalidQuestionnaireAnswers):
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidQuestionnaireAnswers))
+	default:
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+}
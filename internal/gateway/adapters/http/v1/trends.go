@@ -0,0 +1,113 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+// possible trends errors designations
+const (
+	errorInvalidTrendPeriod    = "InvalidTrendPeriod"
+	errorInvalidTrendMetrics   = "InvalidTrendMetrics"
+	errorInvalidTrendsCriteria = "InvalidTrendsCriteria"
+)
+
+func (r *Router) initTrendsRoutes(customerAPI *echo.Group) {
+	customerAPI.GET("/trends", r.getUserTrends, r.identifyUser, r.verifyCustomer)
+}
+
+type getUserTrendsRequest struct {
+	// Metrics are the comma separated basic indicators values names and lab tests codes, all the metrics if empty
+	Metrics string `query:"metrics"`
+	Period  string `query:"period"` // month if empty
+	From    string `query:"from"`   // DateLayout
+	To      string `query:"to"`     // DateLayout
+}
+
+func (r getUserTrendsRequest) criteria(userID uint64) (domain.TrendsCriteria, error) {
+	criteria := domain.TrendsCriteria{
+		UserID: userID,
+		Period: r.Period,
+	}
+
+	if criteria.Period == "" {
+		criteria.Period = common.TrendPeriodMonth
+	}
+
+	if r.Metrics != "" {
+		for _, metric := range strings.Split(r.Metrics, ",") {
+			criteria.Metrics = append(criteria.Metrics, strings.TrimSpace(metric))
+		}
+	}
+
+	var err error
+	if r.From != "" {
+		criteria.From, err = time.Parse(model.DateLayout, r.From)
+		if err != nil {
+			return domain.TrendsCriteria{}, err
+		}
+	}
+	if r.To != "" {
+		criteria.To, err = time.Parse(model.DateLayout, r.To)
+		if err != nil {
+			return domain.TrendsCriteria{}, err
+		}
+	}
+
+	return criteria, nil
+}
+
+type getUserTrendsResponse struct {
+	Period string                `json:"period"`
+	Series []*domain.TrendSeries `json:"series"`
+}
+
+func (r *Router) getUserTrends(c echo.Context) error {
+	var reqData getUserTrendsRequest
+	if err := c.Bind(&reqData); err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	userID := c.Get(ctxKeyUserID).(uint64)
+
+	criteria, err := reqData.criteria(userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, newError(c, err, errorParseRequestData))
+	}
+
+	series, err := r.services.Trends().Find(criteria)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidTrendPeriod):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidTrendPeriod))
+		case errors.Is(err, domain.ErrInvalidTrendMetrics):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidTrendMetrics))
+		case errors.Is(err, domain.ErrInvalidTrendsCriteria):
+			return c.JSON(http.StatusBadRequest, newError(c, err, errorInvalidTrendsCriteria))
+		default:
+			return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+		}
+	}
+
+	preferences, err := r.services.Preferences().Get(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, newError(c, err, errorInternal))
+	}
+
+	for _, metricSeries := range series {
+		metricSeries.ConvertUnits(preferences.UnitSystem)
+	}
+
+	return c.JSON(http.StatusOK, &getUserTrendsResponse{
+		Period: criteria.Period,
+		Series: series,
+	})
+}
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"errors"
 	"fmt"
 	"golang.org/x/sync/errgroup"
 	"net/http"
@@ -28,6 +29,7 @@ func (r *Router) initUsersRoutes(moderatorAPI *echo.Group) {
 	{
 		users.GET("", r.getUsers)
 		users.GET(fmt.Sprintf("/:%v", userIDPathKey), r.getUser)
+		users.GET(fmt.Sprintf("/:%v/evolution", userIDPathKey), r.getUserEvolution)
 	}
 }
 
@@ -118,9 +120,13 @@ type getUserResponseHealth struct {
 	Smoking               *bool    `json:"smoking,omitempty"`
 	TakesStatins          bool     `json:"takesStatins,omitempty"`
 	CVDPredisposed        bool     `json:"cvdPredisposed,omitempty"`
-	CVEventsRiskValue     *int64   `json:"cvEventsRiskValue,omitempty"`
+	CVEventsRiskValue     *float64 `json:"cvEventsRiskValue,omitempty"`
 	SBPLevel              *float64 `json:"sbpLevel,omitempty"`
 	TotalCholesterolLevel *float64 `json:"totalCholesterolLevel,omitempty"`
+	// the current blood pressure category with the transition from the previous one
+	Hypertension *domain.HypertensionClassification `json:"hypertension,omitempty"`
+	// LDL cholesterol goal attainment and the statin therapy indication by the ESC risk category
+	LipidManagement *domain.LipidManagement `json:"lipidManagement,omitempty"`
 }
 
 type getUserResponseLifestyle struct {
@@ -132,6 +138,8 @@ type getUserResponseLifestyle struct {
 	AdherenceDrugTherapy    *float64 `json:"adherenceDrugTherapy,omitempty"`
 	AdherenceMedicalSupport *float64 `json:"adherenceMedicalSupport,omitempty"`
 	AdherenceLifestyleMod   *float64 `json:"adherenceLifestyleMod,omitempty"`
+	// the percentage of the medication doses marked as taken
+	AdherenceDrugTherapyObjective *float64 `json:"adherenceDrugTherapyObjective,omitempty"`
 }
 
 type getUserResponseDiseases struct {
@@ -153,6 +161,8 @@ type getUserResponseAnalyses struct {
 	AtherogenicityCoefficient       *float64 `json:"atherogenicityCoefficient,omitempty"`
 	Creatinine                      *float64 `json:"creatinine,omitempty"`
 	AtheroscleroticPlaquesPresence  *bool    `json:"atheroscleroticPlaquesPresence,omitempty"`
+	// calculated from creatinine
+	EstimatedGlomerularFiltrationRate *float64 `json:"estimatedGlomerularFiltrationRate,omitempty"`
 }
 
 type getUserResponseDynamicValue struct {
@@ -296,6 +306,46 @@ func (r *Router) getUser(c echo.Context) error {
 		return nil
 	})
 
+	g.Go(func() error {
+		var hypertensionHistory *domain.HypertensionHistory
+		hypertensionHistory, err = r.services.Hypertension().History(userID)
+		if err != nil {
+			return err
+		}
+
+		if hypertensionHistory.Current != nil {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if resp.Health == nil {
+				resp.Health = &getUserResponseHealth{}
+			}
+			resp.Health.Hypertension = hypertensionHistory.Current
+		}
+
+		return nil
+	})
+
+	g.Go(func() error {
+		lipidManagement, err := r.services.LipidManagement().Get(userID)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotEnoughDataToClassifyRisk) {
+				return nil
+			}
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if resp.Health == nil {
+			resp.Health = &getUserResponseHealth{}
+		}
+		resp.Health.LipidManagement = lipidManagement
+
+		return nil
+	})
+
 	g.Go(func() error {
 		var userDiseases *domain.Diseases
 		userDiseases, err = r.services.Diseases().Get(userID)
@@ -432,6 +482,28 @@ func (r *Router) getUser(c echo.Context) error {
 		return nil
 	})
 
+	g.Go(func() error {
+		var adherence *domain.MedicationAdherence
+		adherence, err = r.services.Medications().Adherence(domain.MedicationAdherenceCriteria{
+			UserID: userID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if adherence.Percentage != nil {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if resp.Lifestyle == nil {
+				resp.Lifestyle = &getUserResponseLifestyle{}
+			}
+			resp.Lifestyle.AdherenceDrugTherapyObjective = adherence.Percentage
+		}
+
+		return nil
+	})
+
 	g.Go(func() error {
 		var analyses []*domain.Analysis
 		analyses, err = r.services.Analysis().FindAll(userID)
@@ -492,6 +564,12 @@ func (r *Router) getUser(c echo.Context) error {
 					}
 					resp.Analyses.AtheroscleroticPlaquesPresence = analysis.AtheroscleroticPlaquesPresence
 				}
+				if analysis.EstimatedGlomerularFiltrationRate != nil {
+					if resp.Analyses == nil {
+						resp.Analyses = &getUserResponseAnalyses{}
+					}
+					resp.Analyses.EstimatedGlomerularFiltrationRate = analysis.EstimatedGlomerularFiltrationRate
+				}
 			}
 		}
 
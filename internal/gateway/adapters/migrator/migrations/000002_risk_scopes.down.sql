@@ -0,0 +1,18 @@
+ALTER TABLE basic_indicators
+    DROP COLUMN IF EXISTS risk_scope;
+
+DROP TABLE IF EXISTS low_risk_female_not_smoking;
+
+DROP TABLE IF EXISTS low_risk_female_smoking;
+
+DROP TABLE IF EXISTS low_risk_male_not_smoking;
+
+DROP TABLE IF EXISTS low_risk_male_smoking;
+
+DROP TABLE IF EXISTS moderate_risk_female_not_smoking;
+
+DROP TABLE IF EXISTS moderate_risk_female_smoking;
+
+DROP TABLE IF EXISTS moderate_risk_male_not_smoking;
+
+DROP TABLE IF EXISTS moderate_risk_male_smoking;
@@ -0,0 +1,1386 @@
+CREATE TABLE IF NOT EXISTS low_risk_female_not_smoking
+(
+    age_min                     INTEGER       NOT NULL,
+    age_max                     INTEGER       NOT NULL,
+    systolic_blood_pressure_min INTEGER       NOT NULL,
+    systolic_blood_pressure_max INTEGER       NOT NULL,
+    non_hdl_cholesterol_min     DECIMAL(3, 1) NOT NULL,
+    non_hdl_cholesterol_max     DECIMAL(3, 1) NOT NULL,
+    risk_value                  INTEGER       NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS low_risk_female_smoking
+(
+    age_min                     INTEGER       NOT NULL,
+    age_max                     INTEGER       NOT NULL,
+    systolic_blood_pressure_min INTEGER       NOT NULL,
+    systolic_blood_pressure_max INTEGER       NOT NULL,
+    non_hdl_cholesterol_min     DECIMAL(3, 1) NOT NULL,
+    non_hdl_cholesterol_max     DECIMAL(3, 1) NOT NULL,
+    risk_value                  INTEGER       NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS low_risk_male_not_smoking
+(
+    age_min                     INTEGER       NOT NULL,
+    age_max                     INTEGER       NOT NULL,
+    systolic_blood_pressure_min INTEGER       NOT NULL,
+    systolic_blood_pressure_max INTEGER       NOT NULL,
+    non_hdl_cholesterol_min     DECIMAL(3, 1) NOT NULL,
+    non_hdl_cholesterol_max     DECIMAL(3, 1) NOT NULL,
+    risk_value                  INTEGER       NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS low_risk_male_smoking
+(
+    age_min                     INTEGER       NOT NULL,
+    age_max                     INTEGER       NOT NULL,
+    systolic_blood_pressure_min INTEGER       NOT NULL,
+    systolic_blood_pressure_max INTEGER       NOT NULL,
+    non_hdl_cholesterol_min     DECIMAL(3, 1) NOT NULL,
+    non_hdl_cholesterol_max     DECIMAL(3, 1) NOT NULL,
+    risk_value                  INTEGER       NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS moderate_risk_female_not_smoking
+(
+    age_min                     INTEGER       NOT NULL,
+    age_max                     INTEGER       NOT NULL,
+    systolic_blood_pressure_min INTEGER       NOT NULL,
+    systolic_blood_pressure_max INTEGER       NOT NULL,
+    non_hdl_cholesterol_min     DECIMAL(3, 1) NOT NULL,
+    non_hdl_cholesterol_max     DECIMAL(3, 1) NOT NULL,
+    risk_value                  INTEGER       NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS moderate_risk_female_smoking
+(
+    age_min                     INTEGER       NOT NULL,
+    age_max                     INTEGER       NOT NULL,
+    systolic_blood_pressure_min INTEGER       NOT NULL,
+    systolic_blood_pressure_max INTEGER       NOT NULL,
+    non_hdl_cholesterol_min     DECIMAL(3, 1) NOT NULL,
+    non_hdl_cholesterol_max     DECIMAL(3, 1) NOT NULL,
+    risk_value                  INTEGER       NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS moderate_risk_male_not_smoking
+(
+    age_min                     INTEGER       NOT NULL,
+    age_max                     INTEGER       NOT NULL,
+    systolic_blood_pressure_min INTEGER       NOT NULL,
+    systolic_blood_pressure_max INTEGER       NOT NULL,
+    non_hdl_cholesterol_min     DECIMAL(3, 1) NOT NULL,
+    non_hdl_cholesterol_max     DECIMAL(3, 1) NOT NULL,
+    risk_value                  INTEGER       NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS moderate_risk_male_smoking
+(
+    age_min                     INTEGER       NOT NULL,
+    age_max                     INTEGER       NOT NULL,
+    systolic_blood_pressure_min INTEGER       NOT NULL,
+    systolic_blood_pressure_max INTEGER       NOT NULL,
+    non_hdl_cholesterol_min     DECIMAL(3, 1) NOT NULL,
+    non_hdl_cholesterol_max     DECIMAL(3, 1) NOT NULL,
+    risk_value                  INTEGER       NOT NULL
+);
+
+INSERT INTO low_risk_female_not_smoking
+VALUES (85, 89, 160, 179, 3.00000, 3.90000, 28),
+       (85, 89, 160, 179, 4.00000, 4.90000, 29),
+       (85, 89, 160, 179, 5.00000, 5.90000, 30),
+       (85, 89, 160, 179, 6.00000, 6.90000, 31),
+       (85, 89, 140, 159, 3.00000, 3.90000, 26),
+       (85, 89, 140, 159, 4.00000, 4.90000, 27),
+       (85, 89, 140, 159, 5.00000, 5.90000, 28),
+       (85, 89, 140, 159, 6.00000, 6.90000, 29),
+       (85, 89, 120, 139, 3.00000, 3.90000, 24),
+       (85, 89, 120, 139, 4.00000, 4.90000, 25),
+       (85, 89, 120, 139, 5.00000, 5.90000, 26),
+       (85, 89, 120, 139, 6.00000, 6.90000, 27),
+       (85, 89, 100, 119, 3.00000, 3.90000, 22),
+       (85, 89, 100, 119, 4.00000, 4.90000, 23),
+       (85, 89, 100, 119, 5.00000, 5.90000, 24),
+       (85, 89, 100, 119, 6.00000, 6.90000, 25),
+       (80, 84, 160, 179, 3.00000, 3.90000, 20),
+       (80, 84, 160, 179, 4.00000, 4.90000, 21),
+       (80, 84, 160, 179, 5.00000, 5.90000, 22),
+       (80, 84, 160, 179, 6.00000, 6.90000, 24),
+       (80, 84, 140, 159, 3.00000, 3.90000, 18),
+       (80, 84, 140, 159, 4.00000, 4.90000, 19),
+       (80, 84, 140, 159, 5.00000, 5.90000, 20),
+       (80, 84, 140, 159, 6.00000, 6.90000, 21),
+       (80, 84, 120, 139, 3.00000, 3.90000, 16),
+       (80, 84, 120, 139, 4.00000, 4.90000, 17),
+       (80, 84, 120, 139, 5.00000, 5.90000, 18),
+       (80, 84, 120, 139, 6.00000, 6.90000, 19),
+       (80, 84, 100, 119, 3.00000, 3.90000, 14),
+       (80, 84, 100, 119, 4.00000, 4.90000, 15),
+       (80, 84, 100, 119, 5.00000, 5.90000, 16),
+       (80, 84, 100, 119, 6.00000, 6.90000, 17),
+       (75, 79, 160, 179, 3.00000, 3.90000, 15),
+       (75, 79, 160, 179, 4.00000, 4.90000, 16),
+       (75, 79, 160, 179, 5.00000, 5.90000, 16),
+       (75, 79, 160, 179, 6.00000, 6.90000, 17),
+       (75, 79, 140, 159, 3.00000, 3.90000, 13),
+       (75, 79, 140, 159, 4.00000, 4.90000, 13),
+       (75, 79, 140, 159, 5.00000, 5.90000, 14),
+       (75, 79, 140, 159, 6.00000, 6.90000, 15),
+       (75, 79, 120, 139, 3.00000, 3.90000, 11),
+       (75, 79, 120, 139, 4.00000, 4.90000, 11),
+       (75, 79, 120, 139, 5.00000, 5.90000, 12),
+       (75, 79, 120, 139, 6.00000, 6.90000, 13),
+       (75, 79, 100, 119, 3.00000, 3.90000, 9),
+       (75, 79, 100, 119, 4.00000, 4.90000, 10),
+       (75, 79, 100, 119, 5.00000, 5.90000, 10),
+       (75, 79, 100, 119, 6.00000, 6.90000, 11),
+       (70, 74, 160, 179, 3.00000, 3.90000, 11),
+       (70, 74, 160, 179, 4.00000, 4.90000, 11),
+       (70, 74, 160, 179, 5.00000, 5.90000, 12),
+       (70, 74, 160, 179, 6.00000, 6.90000, 13),
+       (70, 74, 140, 159, 3.00000, 3.90000, 9),
+       (70, 74, 140, 159, 4.00000, 4.90000, 9),
+       (70, 74, 140, 159, 5.00000, 5.90000, 10),
+       (70, 74, 140, 159, 6.00000, 6.90000, 10),
+       (70, 74, 120, 139, 3.00000, 3.90000, 7),
+       (70, 74, 120, 139, 4.00000, 4.90000, 8),
+       (70, 74, 120, 139, 5.00000, 5.90000, 8),
+       (70, 74, 120, 139, 6.00000, 6.90000, 8),
+       (70, 74, 100, 119, 3.00000, 3.90000, 6),
+       (70, 74, 100, 119, 4.00000, 4.90000, 6),
+       (70, 74, 100, 119, 5.00000, 5.90000, 7),
+       (70, 74, 100, 119, 6.00000, 6.90000, 7),
+       (65, 69, 160, 179, 3.00000, 3.90000, 8),
+       (65, 69, 160, 179, 4.00000, 4.90000, 8),
+       (65, 69, 160, 179, 5.00000, 5.90000, 9),
+       (65, 69, 160, 179, 6.00000, 6.90000, 9),
+       (65, 69, 140, 159, 3.00000, 3.90000, 7),
+       (65, 69, 140, 159, 4.00000, 4.90000, 7),
+       (65, 69, 140, 159, 5.00000, 5.90000, 7),
+       (65, 69, 140, 159, 6.00000, 6.90000, 8),
+       (65, 69, 120, 139, 3.00000, 3.90000, 6),
+       (65, 69, 120, 139, 4.00000, 4.90000, 6),
+       (65, 69, 120, 139, 5.00000, 5.90000, 6),
+       (65, 69, 120, 139, 6.00000, 6.90000, 6),
+       (65, 69, 100, 119, 3.00000, 3.90000, 5),
+       (65, 69, 100, 119, 4.00000, 4.90000, 5),
+       (65, 69, 100, 119, 5.00000, 5.90000, 5),
+       (65, 69, 100, 119, 6.00000, 6.90000, 5),
+       (60, 64, 160, 179, 3.00000, 3.90000, 6),
+       (60, 64, 160, 179, 4.00000, 4.90000, 6),
+       (60, 64, 160, 179, 5.00000, 5.90000, 7),
+       (60, 64, 160, 179, 6.00000, 6.90000, 7),
+       (60, 64, 140, 159, 3.00000, 3.90000, 5),
+       (60, 64, 140, 159, 4.00000, 4.90000, 5),
+       (60, 64, 140, 159, 5.00000, 5.90000, 6),
+       (60, 64, 140, 159, 6.00000, 6.90000, 6),
+       (60, 64, 120, 139, 3.00000, 3.90000, 4),
+       (60, 64, 120, 139, 4.00000, 4.90000, 4),
+       (60, 64, 120, 139, 5.00000, 5.90000, 4),
+       (60, 64, 120, 139, 6.00000, 6.90000, 5),
+       (60, 64, 100, 119, 3.00000, 3.90000, 3),
+       (60, 64, 100, 119, 4.00000, 4.90000, 3),
+       (60, 64, 100, 119, 5.00000, 5.90000, 4),
+       (60, 64, 100, 119, 6.00000, 6.90000, 4),
+       (55, 59, 160, 179, 3.00000, 3.90000, 4),
+       (55, 59, 160, 179, 4.00000, 4.90000, 5),
+       (55, 59, 160, 179, 5.00000, 5.90000, 5),
+       (55, 59, 160, 179, 6.00000, 6.90000, 6),
+       (55, 59, 140, 159, 3.00000, 3.90000, 4),
+       (55, 59, 140, 159, 4.00000, 4.90000, 4),
+       (55, 59, 140, 159, 5.00000, 5.90000, 4),
+       (55, 59, 140, 159, 6.00000, 6.90000, 5),
+       (55, 59, 120, 139, 3.00000, 3.90000, 3),
+       (55, 59, 120, 139, 4.00000, 4.90000, 3),
+       (55, 59, 120, 139, 5.00000, 5.90000, 3),
+       (55, 59, 120, 139, 6.00000, 6.90000, 4),
+       (55, 59, 100, 119, 3.00000, 3.90000, 2),
+       (55, 59, 100, 119, 4.00000, 4.90000, 2),
+       (55, 59, 100, 119, 5.00000, 5.90000, 3),
+       (55, 59, 100, 119, 6.00000, 6.90000, 3),
+       (50, 54, 160, 179, 3.00000, 3.90000, 3),
+       (50, 54, 160, 179, 4.00000, 4.90000, 4),
+       (50, 54, 160, 179, 5.00000, 5.90000, 4),
+       (50, 54, 160, 179, 6.00000, 6.90000, 4),
+       (50, 54, 140, 159, 3.00000, 3.90000, 3),
+       (50, 54, 140, 159, 4.00000, 4.90000, 3),
+       (50, 54, 140, 159, 5.00000, 5.90000, 3),
+       (50, 54, 140, 159, 6.00000, 6.90000, 3),
+       (50, 54, 120, 139, 3.00000, 3.90000, 2),
+       (50, 54, 120, 139, 4.00000, 4.90000, 2),
+       (50, 54, 120, 139, 5.00000, 5.90000, 2),
+       (50, 54, 120, 139, 6.00000, 6.90000, 3),
+       (50, 54, 100, 119, 3.00000, 3.90000, 2),
+       (50, 54, 100, 119, 4.00000, 4.90000, 2),
+       (50, 54, 100, 119, 5.00000, 5.90000, 2),
+       (50, 54, 100, 119, 6.00000, 6.90000, 2),
+       (45, 49, 160, 179, 3.00000, 3.90000, 3),
+       (45, 49, 160, 179, 4.00000, 4.90000, 3),
+       (45, 49, 160, 179, 5.00000, 5.90000, 3),
+       (45, 49, 160, 179, 6.00000, 6.90000, 3),
+       (45, 49, 140, 159, 3.00000, 3.90000, 2),
+       (45, 49, 140, 159, 4.00000, 4.90000, 2),
+       (45, 49, 140, 159, 5.00000, 5.90000, 2),
+       (45, 49, 140, 159, 6.00000, 6.90000, 3),
+       (45, 49, 120, 139, 3.00000, 3.90000, 1),
+       (45, 49, 120, 139, 4.00000, 4.90000, 2),
+       (45, 49, 120, 139, 5.00000, 5.90000, 2),
+       (45, 49, 120, 139, 6.00000, 6.90000, 2),
+       (45, 49, 100, 119, 3.00000, 3.90000, 1),
+       (45, 49, 100, 119, 4.00000, 4.90000, 1),
+       (45, 49, 100, 119, 5.00000, 5.90000, 1),
+       (45, 49, 100, 119, 6.00000, 6.90000, 2),
+       (40, 44, 160, 179, 3.00000, 3.90000, 2),
+       (40, 44, 160, 179, 4.00000, 4.90000, 2),
+       (40, 44, 160, 179, 5.00000, 5.90000, 2),
+       (40, 44, 160, 179, 6.00000, 6.90000, 3),
+       (40, 44, 140, 159, 3.00000, 3.90000, 1),
+       (40, 44, 140, 159, 4.00000, 4.90000, 2),
+       (40, 44, 140, 159, 5.00000, 5.90000, 2),
+       (40, 44, 140, 159, 6.00000, 6.90000, 2),
+       (40, 44, 120, 139, 3.00000, 3.90000, 1),
+       (40, 44, 120, 139, 4.00000, 4.90000, 1),
+       (40, 44, 120, 139, 5.00000, 5.90000, 1),
+       (40, 44, 120, 139, 6.00000, 6.90000, 2),
+       (40, 44, 100, 119, 3.00000, 3.90000, 1),
+       (40, 44, 100, 119, 4.00000, 4.90000, 1),
+       (40, 44, 100, 119, 5.00000, 5.90000, 1),
+       (40, 44, 100, 119, 6.00000, 6.90000, 1);
+
+INSERT INTO low_risk_female_smoking
+VALUES (85, 89, 160, 179, 3.00000, 3.90000, 31),
+       (85, 89, 160, 179, 4.00000, 4.90000, 32),
+       (85, 89, 160, 179, 5.00000, 5.90000, 33),
+       (85, 89, 160, 179, 6.00000, 6.90000, 35),
+       (85, 89, 140, 159, 3.00000, 3.90000, 29),
+       (85, 89, 140, 159, 4.00000, 4.90000, 30),
+       (85, 89, 140, 159, 5.00000, 5.90000, 31),
+       (85, 89, 140, 159, 6.00000, 6.90000, 32),
+       (85, 89, 120, 139, 3.00000, 3.90000, 27),
+       (85, 89, 120, 139, 4.00000, 4.90000, 28),
+       (85, 89, 120, 139, 5.00000, 5.90000, 29),
+       (85, 89, 120, 139, 6.00000, 6.90000, 30),
+       (85, 89, 100, 119, 3.00000, 3.90000, 25),
+       (85, 89, 100, 119, 4.00000, 4.90000, 26),
+       (85, 89, 100, 119, 5.00000, 5.90000, 27),
+       (85, 89, 100, 119, 6.00000, 6.90000, 28),
+       (80, 84, 160, 179, 3.00000, 3.90000, 26),
+       (80, 84, 160, 179, 4.00000, 4.90000, 27),
+       (80, 84, 160, 179, 5.00000, 5.90000, 28),
+       (80, 84, 160, 179, 6.00000, 6.90000, 29),
+       (80, 84, 140, 159, 3.00000, 3.90000, 23),
+       (80, 84, 140, 159, 4.00000, 4.90000, 24),
+       (80, 84, 140, 159, 5.00000, 5.90000, 25),
+       (80, 84, 140, 159, 6.00000, 6.90000, 26),
+       (80, 84, 120, 139, 3.00000, 3.90000, 20),
+       (80, 84, 120, 139, 4.00000, 4.90000, 21),
+       (80, 84, 120, 139, 5.00000, 5.90000, 22),
+       (80, 84, 120, 139, 6.00000, 6.90000, 23),
+       (80, 84, 100, 119, 3.00000, 3.90000, 18),
+       (80, 84, 100, 119, 4.00000, 4.90000, 19),
+       (80, 84, 100, 119, 5.00000, 5.90000, 20),
+       (80, 84, 100, 119, 6.00000, 6.90000, 21),
+       (75, 79, 160, 179, 3.00000, 3.90000, 21),
+       (75, 79, 160, 179, 4.00000, 4.90000, 22),
+       (75, 79, 160, 179, 5.00000, 5.90000, 23),
+       (75, 79, 160, 179, 6.00000, 6.90000, 24),
+       (75, 79, 140, 159, 3.00000, 3.90000, 18),
+       (75, 79, 140, 159, 4.00000, 4.90000, 19),
+       (75, 79, 140, 159, 5.00000, 5.90000, 20),
+       (75, 79, 140, 159, 6.00000, 6.90000, 21),
+       (75, 79, 120, 139, 3.00000, 3.90000, 15),
+       (75, 79, 120, 139, 4.00000, 4.90000, 16),
+       (75, 79, 120, 139, 5.00000, 5.90000, 17),
+       (75, 79, 120, 139, 6.00000, 6.90000, 18),
+       (75, 79, 100, 119, 3.00000, 3.90000, 13),
+       (75, 79, 100, 119, 4.00000, 4.90000, 14),
+       (75, 79, 100, 119, 5.00000, 5.90000, 15),
+       (75, 79, 100, 119, 6.00000, 6.90000, 15),
+       (70, 74, 160, 179, 3.00000, 3.90000, 17),
+       (70, 74, 160, 179, 4.00000, 4.90000, 18),
+       (70, 74, 160, 179, 5.00000, 5.90000, 19),
+       (70, 74, 160, 179, 6.00000, 6.90000, 20),
+       (70, 74, 140, 159, 3.00000, 3.90000, 14),
+       (70, 74, 140, 159, 4.00000, 4.90000, 15),
+       (70, 74, 140, 159, 5.00000, 5.90000, 16),
+       (70, 74, 140, 159, 6.00000, 6.90000, 17),
+       (70, 74, 120, 139, 3.00000, 3.90000, 12),
+       (70, 74, 120, 139, 4.00000, 4.90000, 12),
+       (70, 74, 120, 139, 5.00000, 5.90000, 13),
+       (70, 74, 120, 139, 6.00000, 6.90000, 14),
+       (70, 74, 100, 119, 3.00000, 3.90000, 9),
+       (70, 74, 100, 119, 4.00000, 4.90000, 10),
+       (70, 74, 100, 119, 5.00000, 5.90000, 11),
+       (70, 74, 100, 119, 6.00000, 6.90000, 11),
+       (65, 69, 160, 179, 3.00000, 3.90000, 12),
+       (65, 69, 160, 179, 4.00000, 4.90000, 13),
+       (65, 69, 160, 179, 5.00000, 5.90000, 13),
+       (65, 69, 160, 179, 6.00000, 6.90000, 14),
+       (65, 69, 140, 159, 3.00000, 3.90000, 10),
+       (65, 69, 140, 159, 4.00000, 4.90000, 10),
+       (65, 69, 140, 159, 5.00000, 5.90000, 11),
+       (65, 69, 140, 159, 6.00000, 6.90000, 11),
+       (65, 69, 120, 139, 3.00000, 3.90000, 8),
+       (65, 69, 120, 139, 4.00000, 4.90000, 9),
+       (65, 69, 120, 139, 5.00000, 5.90000, 9),
+       (65, 69, 120, 139, 6.00000, 6.90000, 10),
+       (65, 69, 100, 119, 3.00000, 3.90000, 7),
+       (65, 69, 100, 119, 4.00000, 4.90000, 7),
+       (65, 69, 100, 119, 5.00000, 5.90000, 8),
+       (65, 69, 100, 119, 6.00000, 6.90000, 8),
+       (60, 64, 160, 179, 3.00000, 3.90000, 10),
+       (60, 64, 160, 179, 4.00000, 4.90000, 10),
+       (60, 64, 160, 179, 5.00000, 5.90000, 11),
+       (60, 64, 160, 179, 6.00000, 6.90000, 12),
+       (60, 64, 140, 159, 3.00000, 3.90000, 8),
+       (60, 64, 140, 159, 4.00000, 4.90000, 8),
+       (60, 64, 140, 159, 5.00000, 5.90000, 9),
+       (60, 64, 140, 159, 6.00000, 6.90000, 10),
+       (60, 64, 120, 139, 3.00000, 3.90000, 7),
+       (60, 64, 120, 139, 4.00000, 4.90000, 7),
+       (60, 64, 120, 139, 5.00000, 5.90000, 7),
+       (60, 64, 120, 139, 6.00000, 6.90000, 8),
+       (60, 64, 100, 119, 3.00000, 3.90000, 5),
+       (60, 64, 100, 119, 4.00000, 4.90000, 6),
+       (60, 64, 100, 119, 5.00000, 5.90000, 6),
+       (60, 64, 100, 119, 6.00000, 6.90000, 6),
+       (55, 59, 160, 179, 3.00000, 3.90000, 8),
+       (55, 59, 160, 179, 4.00000, 4.90000, 9),
+       (55, 59, 160, 179, 5.00000, 5.90000, 9),
+       (55, 59, 160, 179, 6.00000, 6.90000, 10),
+       (55, 59, 140, 159, 3.00000, 3.90000, 6),
+       (55, 59, 140, 159, 4.00000, 4.90000, 7),
+       (55, 59, 140, 159, 5.00000, 5.90000, 7),
+       (55, 59, 140, 159, 6.00000, 6.90000, 8),
+       (55, 59, 120, 139, 3.00000, 3.90000, 5),
+       (55, 59, 120, 139, 4.00000, 4.90000, 5),
+       (55, 59, 120, 139, 5.00000, 5.90000, 6),
+       (55, 59, 120, 139, 6.00000, 6.90000, 6),
+       (55, 59, 100, 119, 3.00000, 3.90000, 4),
+       (55, 59, 100, 119, 4.00000, 4.90000, 4),
+       (55, 59, 100, 119, 5.00000, 5.90000, 5),
+       (55, 59, 100, 119, 6.00000, 6.90000, 5),
+       (50, 54, 160, 179, 3.00000, 3.90000, 6),
+       (50, 54, 160, 179, 4.00000, 4.90000, 7),
+       (50, 54, 160, 179, 5.00000, 5.90000, 8),
+       (50, 54, 160, 179, 6.00000, 6.90000, 8),
+       (50, 54, 140, 159, 3.00000, 3.90000, 5),
+       (50, 54, 140, 159, 4.00000, 4.90000, 5),
+       (50, 54, 140, 159, 5.00000, 5.90000, 6),
+       (50, 54, 140, 159, 6.00000, 6.90000, 7),
+       (50, 54, 120, 139, 3.00000, 3.90000, 4),
+       (50, 54, 120, 139, 4.00000, 4.90000, 4),
+       (50, 54, 120, 139, 5.00000, 5.90000, 5),
+       (50, 54, 120, 139, 6.00000, 6.90000, 5),
+       (50, 54, 100, 119, 3.00000, 3.90000, 3),
+       (50, 54, 100, 119, 4.00000, 4.90000, 3),
+       (50, 54, 100, 119, 5.00000, 5.90000, 4),
+       (50, 54, 100, 119, 6.00000, 6.90000, 4),
+       (45, 49, 160, 179, 3.00000, 3.90000, 5),
+       (45, 49, 160, 179, 4.00000, 4.90000, 6),
+       (45, 49, 160, 179, 5.00000, 5.90000, 6),
+       (45, 49, 160, 179, 6.00000, 6.90000, 7),
+       (45, 49, 140, 159, 3.00000, 3.90000, 4),
+       (45, 49, 140, 159, 4.00000, 4.90000, 4),
+       (45, 49, 140, 159, 5.00000, 5.90000, 5),
+       (45, 49, 140, 159, 6.00000, 6.90000, 5),
+       (45, 49, 120, 139, 3.00000, 3.90000, 3),
+       (45, 49, 120, 139, 4.00000, 4.90000, 3),
+       (45, 49, 120, 139, 5.00000, 5.90000, 4),
+       (45, 49, 120, 139, 6.00000, 6.90000, 4),
+       (45, 49, 100, 119, 3.00000, 3.90000, 2),
+       (45, 49, 100, 119, 4.00000, 4.90000, 3),
+       (45, 49, 100, 119, 5.00000, 5.90000, 3),
+       (45, 49, 100, 119, 6.00000, 6.90000, 3),
+       (40, 44, 160, 179, 3.00000, 3.90000, 4),
+       (40, 44, 160, 179, 4.00000, 4.90000, 5),
+       (40, 44, 160, 179, 5.00000, 5.90000, 5),
+       (40, 44, 160, 179, 6.00000, 6.90000, 6),
+       (40, 44, 140, 159, 3.00000, 3.90000, 3),
+       (40, 44, 140, 159, 4.00000, 4.90000, 4),
+       (40, 44, 140, 159, 5.00000, 5.90000, 4),
+       (40, 44, 140, 159, 6.00000, 6.90000, 5),
+       (40, 44, 120, 139, 3.00000, 3.90000, 2),
+       (40, 44, 120, 139, 4.00000, 4.90000, 3),
+       (40, 44, 120, 139, 5.00000, 5.90000, 3),
+       (40, 44, 120, 139, 6.00000, 6.90000, 3),
+       (40, 44, 100, 119, 3.00000, 3.90000, 2),
+       (40, 44, 100, 119, 4.00000, 4.90000, 2),
+       (40, 44, 100, 119, 5.00000, 5.90000, 2),
+       (40, 44, 100, 119, 6.00000, 6.90000, 3);
+
+INSERT INTO low_risk_male_not_smoking
+VALUES (85, 89, 160, 179, 3.00000, 3.90000, 29),
+       (85, 89, 160, 179, 4.00000, 4.90000, 35),
+       (85, 89, 160, 179, 5.00000, 5.90000, 42),
+       (85, 89, 160, 179, 6.00000, 6.90000, 49),
+       (85, 89, 140, 159, 3.00000, 3.90000, 28),
+       (85, 89, 140, 159, 4.00000, 4.90000, 33),
+       (85, 89, 140, 159, 5.00000, 5.90000, 40),
+       (85, 89, 140, 159, 6.00000, 6.90000, 47),
+       (85, 89, 120, 139, 3.00000, 3.90000, 26),
+       (85, 89, 120, 139, 4.00000, 4.90000, 32),
+       (85, 89, 120, 139, 5.00000, 5.90000, 38),
+       (85, 89, 120, 139, 6.00000, 6.90000, 46),
+       (85, 89, 100, 119, 3.00000, 3.90000, 25),
+       (85, 89, 100, 119, 4.00000, 4.90000, 31),
+       (85, 89, 100, 119, 5.00000, 5.90000, 37),
+       (85, 89, 100, 119, 6.00000, 6.90000, 44),
+       (80, 84, 160, 179, 3.00000, 3.90000, 23),
+       (80, 84, 160, 179, 4.00000, 4.90000, 27),
+       (80, 84, 160, 179, 5.00000, 5.90000, 32),
+       (80, 84, 160, 179, 6.00000, 6.90000, 37),
+       (80, 84, 140, 159, 3.00000, 3.90000, 21),
+       (80, 84, 140, 159, 4.00000, 4.90000, 25),
+       (80, 84, 140, 159, 5.00000, 5.90000, 29),
+       (80, 84, 140, 159, 6.00000, 6.90000, 34),
+       (80, 84, 120, 139, 3.00000, 3.90000, 19),
+       (80, 84, 120, 139, 4.00000, 4.90000, 22),
+       (80, 84, 120, 139, 5.00000, 5.90000, 26),
+       (80, 84, 120, 139, 6.00000, 6.90000, 31),
+       (80, 84, 100, 119, 3.00000, 3.90000, 17),
+       (80, 84, 100, 119, 4.00000, 4.90000, 20),
+       (80, 84, 100, 119, 5.00000, 5.90000, 24),
+       (80, 84, 100, 119, 6.00000, 6.90000, 28),
+       (75, 79, 160, 179, 3.00000, 3.90000, 18),
+       (75, 79, 160, 179, 4.00000, 4.90000, 21),
+       (75, 79, 160, 179, 5.00000, 5.90000, 24),
+       (75, 79, 160, 179, 6.00000, 6.90000, 27),
+       (75, 79, 140, 159, 3.00000, 3.90000, 16),
+       (75, 79, 140, 159, 4.00000, 4.90000, 18),
+       (75, 79, 140, 159, 5.00000, 5.90000, 20),
+       (75, 79, 140, 159, 6.00000, 6.90000, 23),
+       (75, 79, 120, 139, 3.00000, 3.90000, 13),
+       (75, 79, 120, 139, 4.00000, 4.90000, 15),
+       (75, 79, 120, 139, 5.00000, 5.90000, 17),
+       (75, 79, 120, 139, 6.00000, 6.90000, 20),
+       (75, 79, 100, 119, 3.00000, 3.90000, 11),
+       (75, 79, 100, 119, 4.00000, 4.90000, 13),
+       (75, 79, 100, 119, 5.00000, 5.90000, 15),
+       (75, 79, 100, 119, 6.00000, 6.90000, 17),
+       (70, 74, 160, 179, 3.00000, 3.90000, 14),
+       (70, 74, 160, 179, 4.00000, 4.90000, 16),
+       (70, 74, 160, 179, 5.00000, 5.90000, 17),
+       (70, 74, 160, 179, 6.00000, 6.90000, 19),
+       (70, 74, 140, 159, 3.00000, 3.90000, 12),
+       (70, 74, 140, 159, 4.00000, 4.90000, 13),
+       (70, 74, 140, 159, 5.00000, 5.90000, 14),
+       (70, 74, 140, 159, 6.00000, 6.90000, 15),
+       (70, 74, 120, 139, 3.00000, 3.90000, 9),
+       (70, 74, 120, 139, 4.00000, 4.90000, 10),
+       (70, 74, 120, 139, 5.00000, 5.90000, 11),
+       (70, 74, 120, 139, 6.00000, 6.90000, 12),
+       (70, 74, 100, 119, 3.00000, 3.90000, 8),
+       (70, 74, 100, 119, 4.00000, 4.90000, 8),
+       (70, 74, 100, 119, 5.00000, 5.90000, 9),
+       (70, 74, 100, 119, 6.00000, 6.90000, 10),
+       (65, 69, 160, 179, 3.00000, 3.90000, 11),
+       (65, 69, 160, 179, 4.00000, 4.90000, 11),
+       (65, 69, 160, 179, 5.00000, 5.90000, 12),
+       (65, 69, 160, 179, 6.00000, 6.90000, 13),
+       (65, 69, 140, 159, 3.00000, 3.90000, 9),
+       (65, 69, 140, 159, 4.00000, 4.90000, 10),
+       (65, 69, 140, 159, 5.00000, 5.90000, 10),
+       (65, 69, 140, 159, 6.00000, 6.90000, 11),
+       (65, 69, 120, 139, 3.00000, 3.90000, 7),
+       (65, 69, 120, 139, 4.00000, 4.90000, 8),
+       (65, 69, 120, 139, 5.00000, 5.90000, 9),
+       (65, 69, 120, 139, 6.00000, 6.90000, 9),
+       (65, 69, 100, 119, 3.00000, 3.90000, 6),
+       (65, 69, 100, 119, 4.00000, 4.90000, 7),
+       (65, 69, 100, 119, 5.00000, 5.90000, 7),
+       (65, 69, 100, 119, 6.00000, 6.90000, 8),
+       (60, 64, 160, 179, 3.00000, 3.90000, 8),
+       (60, 64, 160, 179, 4.00000, 4.90000, 9),
+       (60, 64, 160, 179, 5.00000, 5.90000, 10),
+       (60, 64, 160, 179, 6.00000, 6.90000, 11),
+       (60, 64, 140, 159, 3.00000, 3.90000, 7),
+       (60, 64, 140, 159, 4.00000, 4.90000, 7),
+       (60, 64, 140, 159, 5.00000, 5.90000, 8),
+       (60, 64, 140, 159, 6.00000, 6.90000, 9),
+       (60, 64, 120, 139, 3.00000, 3.90000, 6),
+       (60, 64, 120, 139, 4.00000, 4.90000, 6),
+       (60, 64, 120, 139, 5.00000, 5.90000, 7),
+       (60, 64, 120, 139, 6.00000, 6.90000, 7),
+       (60, 64, 100, 119, 3.00000, 3.90000, 5),
+       (60, 64, 100, 119, 4.00000, 4.90000, 5),
+       (60, 64, 100, 119, 5.00000, 5.90000, 6),
+       (60, 64, 100, 119, 6.00000, 6.90000, 6),
+       (55, 59, 160, 179, 3.00000, 3.90000, 6),
+       (55, 59, 160, 179, 4.00000, 4.90000, 7),
+       (55, 59, 160, 179, 5.00000, 5.90000, 8),
+       (55, 59, 160, 179, 6.00000, 6.90000, 9),
+       (55, 59, 140, 159, 3.00000, 3.90000, 5),
+       (55, 59, 140, 159, 4.00000, 4.90000, 6),
+       (55, 59, 140, 159, 5.00000, 5.90000, 7),
+       (55, 59, 140, 159, 6.00000, 6.90000, 7),
+       (55, 59, 120, 139, 3.00000, 3.90000, 4),
+       (55, 59, 120, 139, 4.00000, 4.90000, 5),
+       (55, 59, 120, 139, 5.00000, 5.90000, 5),
+       (55, 59, 120, 139, 6.00000, 6.90000, 6),
+       (55, 59, 100, 119, 3.00000, 3.90000, 3),
+       (55, 59, 100, 119, 4.00000, 4.90000, 4),
+       (55, 59, 100, 119, 5.00000, 5.90000, 4),
+       (55, 59, 100, 119, 6.00000, 6.90000, 5),
+       (50, 54, 160, 179, 3.00000, 3.90000, 5),
+       (50, 54, 160, 179, 4.00000, 4.90000, 6),
+       (50, 54, 160, 179, 5.00000, 5.90000, 7),
+       (50, 54, 160, 179, 6.00000, 6.90000, 8),
+       (50, 54, 140, 159, 3.00000, 3.90000, 4),
+       (50, 54, 140, 159, 4.00000, 4.90000, 5),
+       (50, 54, 140, 159, 5.00000, 5.90000, 5),
+       (50, 54, 140, 159, 6.00000, 6.90000, 6),
+       (50, 54, 120, 139, 3.00000, 3.90000, 3),
+       (50, 54, 120, 139, 4.00000, 4.90000, 4),
+       (50, 54, 120, 139, 5.00000, 5.90000, 4),
+       (50, 54, 120, 139, 6.00000, 6.90000, 5),
+       (50, 54, 100, 119, 3.00000, 3.90000, 2),
+       (50, 54, 100, 119, 4.00000, 4.90000, 3),
+       (50, 54, 100, 119, 5.00000, 5.90000, 3),
+       (50, 54, 100, 119, 6.00000, 6.90000, 4),
+       (45, 49, 160, 179, 3.00000, 3.90000, 4),
+       (45, 49, 160, 179, 4.00000, 4.90000, 5),
+       (45, 49, 160, 179, 5.00000, 5.90000, 5),
+       (45, 49, 160, 179, 6.00000, 6.90000, 6),
+       (45, 49, 140, 159, 3.00000, 3.90000, 3),
+       (45, 49, 140, 159, 4.00000, 4.90000, 4),
+       (45, 49, 140, 159, 5.00000, 5.90000, 4),
+       (45, 49, 140, 159, 6.00000, 6.90000, 5),
+       (45, 49, 120, 139, 3.00000, 3.90000, 2),
+       (45, 49, 120, 139, 4.00000, 4.90000, 3),
+       (45, 49, 120, 139, 5.00000, 5.90000, 3),
+       (45, 49, 120, 139, 6.00000, 6.90000, 4),
+       (45, 49, 100, 119, 3.00000, 3.90000, 2),
+       (45, 49, 100, 119, 4.00000, 4.90000, 2),
+       (45, 49, 100, 119, 5.00000, 5.90000, 3),
+       (45, 49, 100, 119, 6.00000, 6.90000, 3),
+       (40, 44, 160, 179, 3.00000, 3.90000, 3),
+       (40, 44, 160, 179, 4.00000, 4.90000, 4),
+       (40, 44, 160, 179, 5.00000, 5.90000, 4),
+       (40, 44, 160, 179, 6.00000, 6.90000, 5),
+       (40, 44, 140, 159, 3.00000, 3.90000, 2),
+       (40, 44, 140, 159, 4.00000, 4.90000, 3),
+       (40, 44, 140, 159, 5.00000, 5.90000, 3),
+       (40, 44, 140, 159, 6.00000, 6.90000, 4),
+       (40, 44, 120, 139, 3.00000, 3.90000, 2),
+       (40, 44, 120, 139, 4.00000, 4.90000, 2),
+       (40, 44, 120, 139, 5.00000, 5.90000, 3),
+       (40, 44, 120, 139, 6.00000, 6.90000, 3),
+       (40, 44, 100, 119, 3.00000, 3.90000, 1),
+       (40, 44, 100, 119, 4.00000, 4.90000, 2),
+       (40, 44, 100, 119, 5.00000, 5.90000, 2),
+       (40, 44, 100, 119, 6.00000, 6.90000, 2);
+
+INSERT INTO low_risk_male_smoking
+VALUES (85, 89, 160, 179, 3.00000, 3.90000, 29),
+       (85, 89, 160, 179, 4.00000, 4.90000, 35),
+       (85, 89, 160, 179, 5.00000, 5.90000, 41),
+       (85, 89, 160, 179, 6.00000, 6.90000, 49),
+       (85, 89, 140, 159, 3.00000, 3.90000, 28),
+       (85, 89, 140, 159, 4.00000, 4.90000, 33),
+       (85, 89, 140, 159, 5.00000, 5.90000, 40),
+       (85, 89, 140, 159, 6.00000, 6.90000, 47),
+       (85, 89, 120, 139, 3.00000, 3.90000, 26),
+       (85, 89, 120, 139, 4.00000, 4.90000, 32),
+       (85, 89, 120, 139, 5.00000, 5.90000, 38),
+       (85, 89, 120, 139, 6.00000, 6.90000, 45),
+       (85, 89, 100, 119, 3.00000, 3.90000, 25),
+       (85, 89, 100, 119, 4.00000, 4.90000, 31),
+       (85, 89, 100, 119, 5.00000, 5.90000, 37),
+       (85, 89, 100, 119, 6.00000, 6.90000, 44),
+       (80, 84, 160, 179, 3.00000, 3.90000, 26),
+       (80, 84, 160, 179, 4.00000, 4.90000, 30),
+       (80, 84, 160, 179, 5.00000, 5.90000, 35),
+       (80, 84, 160, 179, 6.00000, 6.90000, 41),
+       (80, 84, 140, 159, 3.00000, 3.90000, 24),
+       (80, 84, 140, 159, 4.00000, 4.90000, 28),
+       (80, 84, 140, 159, 5.00000, 5.90000, 32),
+       (80, 84, 140, 159, 6.00000, 6.90000, 37),
+       (80, 84, 120, 139, 3.00000, 3.90000, 22),
+       (80, 84, 120, 139, 4.00000, 4.90000, 25),
+       (80, 84, 120, 139, 5.00000, 5.90000, 30),
+       (80, 84, 120, 139, 6.00000, 6.90000, 34),
+       (80, 84, 100, 119, 3.00000, 3.90000, 19),
+       (80, 84, 100, 119, 4.00000, 4.90000, 23),
+       (80, 84, 100, 119, 5.00000, 5.90000, 27),
+       (80, 84, 100, 119, 6.00000, 6.90000, 31),
+       (75, 79, 160, 179, 3.00000, 3.90000, 24),
+       (75, 79, 160, 179, 4.00000, 4.90000, 27),
+       (75, 79, 160, 179, 5.00000, 5.90000, 30),
+       (75, 79, 160, 179, 6.00000, 6.90000, 34),
+       (75, 79, 140, 159, 3.00000, 3.90000, 20),
+       (75, 79, 140, 159, 4.00000, 4.90000, 23),
+       (75, 79, 140, 159, 5.00000, 5.90000, 26),
+       (75, 79, 140, 159, 6.00000, 6.90000, 29),
+       (75, 79, 120, 139, 3.00000, 3.90000, 17),
+       (75, 79, 120, 139, 4.00000, 4.90000, 20),
+       (75, 79, 120, 139, 5.00000, 5.90000, 22),
+       (75, 79, 120, 139, 6.00000, 6.90000, 25),
+       (75, 79, 100, 119, 3.00000, 3.90000, 15),
+       (75, 79, 100, 119, 4.00000, 4.90000, 17),
+       (75, 79, 100, 119, 5.00000, 5.90000, 19),
+       (75, 79, 100, 119, 6.00000, 6.90000, 22),
+       (70, 74, 160, 179, 3.00000, 3.90000, 21),
+       (70, 74, 160, 179, 4.00000, 4.90000, 23),
+       (70, 74, 160, 179, 5.00000, 5.90000, 25),
+       (70, 74, 160, 179, 6.00000, 6.90000, 28),
+       (70, 74, 140, 159, 3.00000, 3.90000, 17),
+       (70, 74, 140, 159, 4.00000, 4.90000, 19),
+       (70, 74, 140, 159, 5.00000, 5.90000, 21),
+       (70, 74, 140, 159, 6.00000, 6.90000, 23),
+       (70, 74, 120, 139, 3.00000, 3.90000, 14),
+       (70, 74, 120, 139, 4.00000, 4.90000, 15),
+       (70, 74, 120, 139, 5.00000, 5.90000, 17),
+       (70, 74, 120, 139, 6.00000, 6.90000, 18),
+       (70, 74, 100, 119, 3.00000, 3.90000, 11),
+       (70, 74, 100, 119, 4.00000, 4.90000, 12),
+       (70, 74, 100, 119, 5.00000, 5.90000, 14),
+       (70, 74, 100, 119, 6.00000, 6.90000, 15),
+       (65, 69, 160, 179, 3.00000, 3.90000, 15),
+       (65, 69, 160, 179, 4.00000, 4.90000, 16),
+       (65, 69, 160, 179, 5.00000, 5.90000, 17),
+       (65, 69, 160, 179, 6.00000, 6.90000, 18),
+       (65, 69, 140, 159, 3.00000, 3.90000, 13),
+       (65, 69, 140, 159, 4.00000, 4.90000, 13),
+       (65, 69, 140, 159, 5.00000, 5.90000, 14),
+       (65, 69, 140, 159, 6.00000, 6.90000, 16),
+       (65, 69, 120, 139, 3.00000, 3.90000, 11),
+       (65, 69, 120, 139, 4.00000, 4.90000, 11),
+       (65, 69, 120, 139, 5.00000, 5.90000, 12),
+       (65, 69, 120, 139, 6.00000, 6.90000, 13),
+       (65, 69, 100, 119, 3.00000, 3.90000, 9),
+       (65, 69, 100, 119, 4.00000, 4.90000, 10),
+       (65, 69, 100, 119, 5.00000, 5.90000, 10),
+       (65, 69, 100, 119, 6.00000, 6.90000, 11),
+       (60, 64, 160, 179, 3.00000, 3.90000, 12),
+       (60, 64, 160, 179, 4.00000, 4.90000, 13),
+       (60, 64, 160, 179, 5.00000, 5.90000, 15),
+       (60, 64, 160, 179, 6.00000, 6.90000, 16),
+       (60, 64, 140, 159, 3.00000, 3.90000, 10),
+       (60, 64, 140, 159, 4.00000, 4.90000, 11),
+       (60, 64, 140, 159, 5.00000, 5.90000, 12),
+       (60, 64, 140, 159, 6.00000, 6.90000, 13),
+       (60, 64, 120, 139, 3.00000, 3.90000, 8),
+       (60, 64, 120, 139, 4.00000, 4.90000, 9),
+       (60, 64, 120, 139, 5.00000, 5.90000, 10),
+       (60, 64, 120, 139, 6.00000, 6.90000, 11),
+       (60, 64, 100, 119, 3.00000, 3.90000, 7),
+       (60, 64, 100, 119, 4.00000, 4.90000, 8),
+       (60, 64, 100, 119, 5.00000, 5.90000, 8),
+       (60, 64, 100, 119, 6.00000, 6.90000, 9),
+       (55, 59, 160, 179, 3.00000, 3.90000, 10),
+       (55, 59, 160, 179, 4.00000, 4.90000, 11),
+       (55, 59, 160, 179, 5.00000, 5.90000, 13),
+       (55, 59, 160, 179, 6.00000, 6.90000, 14),
+       (55, 59, 140, 159, 3.00000, 3.90000, 8),
+       (55, 59, 140, 159, 4.00000, 4.90000, 9),
+       (55, 59, 140, 159, 5.00000, 5.90000, 10),
+       (55, 59, 140, 159, 6.00000, 6.90000, 12),
+       (55, 59, 120, 139, 3.00000, 3.90000, 7),
+       (55, 59, 120, 139, 4.00000, 4.90000, 8),
+       (55, 59, 120, 139, 5.00000, 5.90000, 8),
+       (55, 59, 120, 139, 6.00000, 6.90000, 9),
+       (55, 59, 100, 119, 3.00000, 3.90000, 5),
+       (55, 59, 100, 119, 4.00000, 4.90000, 6),
+       (55, 59, 100, 119, 5.00000, 5.90000, 7),
+       (55, 59, 100, 119, 6.00000, 6.90000, 8),
+       (50, 54, 160, 179, 3.00000, 3.90000, 8),
+       (50, 54, 160, 179, 4.00000, 4.90000, 10),
+       (50, 54, 160, 179, 5.00000, 5.90000, 11),
+       (50, 54, 160, 179, 6.00000, 6.90000, 13),
+       (50, 54, 140, 159, 3.00000, 3.90000, 7),
+       (50, 54, 140, 159, 4.00000, 4.90000, 8),
+       (50, 54, 140, 159, 5.00000, 5.90000, 9),
+       (50, 54, 140, 159, 6.00000, 6.90000, 10),
+       (50, 54, 120, 139, 3.00000, 3.90000, 5),
+       (50, 54, 120, 139, 4.00000, 4.90000, 6),
+       (50, 54, 120, 139, 5.00000, 5.90000, 7),
+       (50, 54, 120, 139, 6.00000, 6.90000, 8),
+       (50, 54, 100, 119, 3.00000, 3.90000, 4),
+       (50, 54, 100, 119, 4.00000, 4.90000, 5),
+       (50, 54, 100, 119, 5.00000, 5.90000, 6),
+       (50, 54, 100, 119, 6.00000, 6.90000, 6),
+       (45, 49, 160, 179, 3.00000, 3.90000, 7),
+       (45, 49, 160, 179, 4.00000, 4.90000, 8),
+       (45, 49, 160, 179, 5.00000, 5.90000, 9),
+       (45, 49, 160, 179, 6.00000, 6.90000, 11),
+       (45, 49, 140, 159, 3.00000, 3.90000, 5),
+       (45, 49, 140, 159, 4.00000, 4.90000, 6),
+       (45, 49, 140, 159, 5.00000, 5.90000, 7),
+       (45, 49, 140, 159, 6.00000, 6.90000, 9),
+       (45, 49, 120, 139, 3.00000, 3.90000, 4),
+       (45, 49, 120, 139, 4.00000, 4.90000, 5),
+       (45, 49, 120, 139, 5.00000, 5.90000, 6),
+       (45, 49, 120, 139, 6.00000, 6.90000, 7),
+       (45, 49, 100, 119, 3.00000, 3.90000, 3),
+       (45, 49, 100, 119, 4.00000, 4.90000, 4),
+       (45, 49, 100, 119, 5.00000, 5.90000, 5),
+       (45, 49, 100, 119, 6.00000, 6.90000, 5),
+       (40, 44, 160, 179, 3.00000, 3.90000, 6),
+       (40, 44, 160, 179, 4.00000, 4.90000, 7),
+       (40, 44, 160, 179, 5.00000, 5.90000, 8),
+       (40, 44, 160, 179, 6.00000, 6.90000, 10),
+       (40, 44, 140, 159, 3.00000, 3.90000, 4),
+       (40, 44, 140, 159, 4.00000, 4.90000, 5),
+       (40, 44, 140, 159, 5.00000, 5.90000, 6),
+       (40, 44, 140, 159, 6.00000, 6.90000, 7),
+       (40, 44, 120, 139, 3.00000, 3.90000, 3),
+       (40, 44, 120, 139, 4.00000, 4.90000, 4),
+       (40, 44, 120, 139, 5.00000, 5.90000, 5),
+       (40, 44, 120, 139, 6.00000, 6.90000, 6),
+       (40, 44, 100, 119, 3.00000, 3.90000, 3),
+       (40, 44, 100, 119, 4.00000, 4.90000, 3),
+       (40, 44, 100, 119, 5.00000, 5.90000, 4),
+       (40, 44, 100, 119, 6.00000, 6.90000, 4);
+
+INSERT INTO moderate_risk_female_not_smoking
+VALUES (85, 89, 160, 179, 3.00000, 3.90000, 38),
+       (85, 89, 160, 179, 4.00000, 4.90000, 39),
+       (85, 89, 160, 179, 5.00000, 5.90000, 41),
+       (85, 89, 160, 179, 6.00000, 6.90000, 42),
+       (85, 89, 140, 159, 3.00000, 3.90000, 35),
+       (85, 89, 140, 159, 4.00000, 4.90000, 36),
+       (85, 89, 140, 159, 5.00000, 5.90000, 38),
+       (85, 89, 140, 159, 6.00000, 6.90000, 39),
+       (85, 89, 120, 139, 3.00000, 3.90000, 32),
+       (85, 89, 120, 139, 4.00000, 4.90000, 34),
+       (85, 89, 120, 139, 5.00000, 5.90000, 35),
+       (85, 89, 120, 139, 6.00000, 6.90000, 37),
+       (85, 89, 100, 119, 3.00000, 3.90000, 30),
+       (85, 89, 100, 119, 4.00000, 4.90000, 31),
+       (85, 89, 100, 119, 5.00000, 5.90000, 32),
+       (85, 89, 100, 119, 6.00000, 6.90000, 34),
+       (80, 84, 160, 179, 3.00000, 3.90000, 27),
+       (80, 84, 160, 179, 4.00000, 4.90000, 29),
+       (80, 84, 160, 179, 5.00000, 5.90000, 30),
+       (80, 84, 160, 179, 6.00000, 6.90000, 32),
+       (80, 84, 140, 159, 3.00000, 3.90000, 24),
+       (80, 84, 140, 159, 4.00000, 4.90000, 26),
+       (80, 84, 140, 159, 5.00000, 5.90000, 27),
+       (80, 84, 140, 159, 6.00000, 6.90000, 28),
+       (80, 84, 120, 139, 3.00000, 3.90000, 21),
+       (80, 84, 120, 139, 4.00000, 4.90000, 23),
+       (80, 84, 120, 139, 5.00000, 5.90000, 24),
+       (80, 84, 120, 139, 6.00000, 6.90000, 25),
+       (80, 84, 100, 119, 3.00000, 3.90000, 19),
+       (80, 84, 100, 119, 4.00000, 4.90000, 20),
+       (80, 84, 100, 119, 5.00000, 5.90000, 21),
+       (80, 84, 100, 119, 6.00000, 6.90000, 22),
+       (75, 79, 160, 179, 3.00000, 3.90000, 20),
+       (75, 79, 160, 179, 4.00000, 4.90000, 21),
+       (75, 79, 160, 179, 5.00000, 5.90000, 22),
+       (75, 79, 160, 179, 6.00000, 6.90000, 23),
+       (75, 79, 140, 159, 3.00000, 3.90000, 17),
+       (75, 79, 140, 159, 4.00000, 4.90000, 17),
+       (75, 79, 140, 159, 5.00000, 5.90000, 18),
+       (75, 79, 140, 159, 6.00000, 6.90000, 20),
+       (75, 79, 120, 139, 3.00000, 3.90000, 14),
+       (75, 79, 120, 139, 4.00000, 4.90000, 15),
+       (75, 79, 120, 139, 5.00000, 5.90000, 16),
+       (75, 79, 120, 139, 6.00000, 6.90000, 17),
+       (75, 79, 100, 119, 3.00000, 3.90000, 12),
+       (75, 79, 100, 119, 4.00000, 4.90000, 12),
+       (75, 79, 100, 119, 5.00000, 5.90000, 13),
+       (75, 79, 100, 119, 6.00000, 6.90000, 14),
+       (70, 74, 160, 179, 3.00000, 3.90000, 14),
+       (70, 74, 160, 179, 4.00000, 4.90000, 15),
+       (70, 74, 160, 179, 5.00000, 5.90000, 16),
+       (70, 74, 160, 179, 6.00000, 6.90000, 17),
+       (70, 74, 140, 159, 3.00000, 3.90000, 11),
+       (70, 74, 140, 159, 4.00000, 4.90000, 12),
+       (70, 74, 140, 159, 5.00000, 5.90000, 13),
+       (70, 74, 140, 159, 6.00000, 6.90000, 13),
+       (70, 74, 120, 139, 3.00000, 3.90000, 9),
+       (70, 74, 120, 139, 4.00000, 4.90000, 9),
+       (70, 74, 120, 139, 5.00000, 5.90000, 10),
+       (70, 74, 120, 139, 6.00000, 6.90000, 11),
+       (70, 74, 100, 119, 3.00000, 3.90000, 7),
+       (70, 74, 100, 119, 4.00000, 4.90000, 8),
+       (70, 74, 100, 119, 5.00000, 5.90000, 8),
+       (70, 74, 100, 119, 6.00000, 6.90000, 9),
+       (65, 69, 160, 179, 3.00000, 3.90000, 10),
+       (65, 69, 160, 179, 4.00000, 4.90000, 11),
+       (65, 69, 160, 179, 5.00000, 5.90000, 11),
+       (65, 69, 160, 179, 6.00000, 6.90000, 12),
+       (65, 69, 140, 159, 3.00000, 3.90000, 8),
+       (65, 69, 140, 159, 4.00000, 4.90000, 9),
+       (65, 69, 140, 159, 5.00000, 5.90000, 9),
+       (65, 69, 140, 159, 6.00000, 6.90000, 10),
+       (65, 69, 120, 139, 3.00000, 3.90000, 7),
+       (65, 69, 120, 139, 4.00000, 4.90000, 7),
+       (65, 69, 120, 139, 5.00000, 5.90000, 8),
+       (65, 69, 120, 139, 6.00000, 6.90000, 8),
+       (65, 69, 100, 119, 3.00000, 3.90000, 6),
+       (65, 69, 100, 119, 4.00000, 4.90000, 6),
+       (65, 69, 100, 119, 5.00000, 5.90000, 6),
+       (65, 69, 100, 119, 6.00000, 6.90000, 6),
+       (60, 64, 160, 179, 3.00000, 3.90000, 7),
+       (60, 64, 160, 179, 4.00000, 4.90000, 8),
+       (60, 64, 160, 179, 5.00000, 5.90000, 8),
+       (60, 64, 160, 179, 6.00000, 6.90000, 9),
+       (60, 64, 140, 159, 3.00000, 3.90000, 6),
+       (60, 64, 140, 159, 4.00000, 4.90000, 6),
+       (60, 64, 140, 159, 5.00000, 5.90000, 7),
+       (60, 64, 140, 159, 6.00000, 6.90000, 7),
+       (60, 64, 120, 139, 3.00000, 3.90000, 5),
+       (60, 64, 120, 139, 4.00000, 4.90000, 5),
+       (60, 64, 120, 139, 5.00000, 5.90000, 5),
+       (60, 64, 120, 139, 6.00000, 6.90000, 6),
+       (60, 64, 100, 119, 3.00000, 3.90000, 4),
+       (60, 64, 100, 119, 4.00000, 4.90000, 4),
+       (60, 64, 100, 119, 5.00000, 5.90000, 4),
+       (60, 64, 100, 119, 6.00000, 6.90000, 5),
+       (55, 59, 160, 179, 3.00000, 3.90000, 5),
+       (55, 59, 160, 179, 4.00000, 4.90000, 6),
+       (55, 59, 160, 179, 5.00000, 5.90000, 6),
+       (55, 59, 160, 179, 6.00000, 6.90000, 7),
+       (55, 59, 140, 159, 3.00000, 3.90000, 4),
+       (55, 59, 140, 159, 4.00000, 4.90000, 5),
+       (55, 59, 140, 159, 5.00000, 5.90000, 5),
+       (55, 59, 140, 159, 6.00000, 6.90000, 5),
+       (55, 59, 120, 139, 3.00000, 3.90000, 3),
+       (55, 59, 120, 139, 4.00000, 4.90000, 4),
+       (55, 59, 120, 139, 5.00000, 5.90000, 4),
+       (55, 59, 120, 139, 6.00000, 6.90000, 4),
+       (55, 59, 100, 119, 3.00000, 3.90000, 3),
+       (55, 59, 100, 119, 4.00000, 4.90000, 3),
+       (55, 59, 100, 119, 5.00000, 5.90000, 3),
+       (55, 59, 100, 119, 6.00000, 6.90000, 3),
+       (50, 54, 160, 179, 3.00000, 3.90000, 4),
+       (50, 54, 160, 179, 4.00000, 4.90000, 4),
+       (50, 54, 160, 179, 5.00000, 5.90000, 5),
+       (50, 54, 160, 179, 6.00000, 6.90000, 5),
+       (50, 54, 140, 159, 3.00000, 3.90000, 3),
+       (50, 54, 140, 159, 4.00000, 4.90000, 3),
+       (50, 54, 140, 159, 5.00000, 5.90000, 4),
+       (50, 54, 140, 159, 6.00000, 6.90000, 4),
+       (50, 54, 120, 139, 3.00000, 3.90000, 2),
+       (50, 54, 120, 139, 4.00000, 4.90000, 3),
+       (50, 54, 120, 139, 5.00000, 5.90000, 3),
+       (50, 54, 120, 139, 6.00000, 6.90000, 3),
+       (50, 54, 100, 119, 3.00000, 3.90000, 2),
+       (50, 54, 100, 119, 4.00000, 4.90000, 2),
+       (50, 54, 100, 119, 5.00000, 5.90000, 2),
+       (50, 54, 100, 119, 6.00000, 6.90000, 2),
+       (45, 49, 160, 179, 3.00000, 3.90000, 3),
+       (45, 49, 160, 179, 4.00000, 4.90000, 3),
+       (45, 49, 160, 179, 5.00000, 5.90000, 4),
+       (45, 49, 160, 179, 6.00000, 6.90000, 4),
+       (45, 49, 140, 159, 3.00000, 3.90000, 2),
+       (45, 49, 140, 159, 4.00000, 4.90000, 2),
+       (45, 49, 140, 159, 5.00000, 5.90000, 3),
+       (45, 49, 140, 159, 6.00000, 6.90000, 3),
+       (45, 49, 120, 139, 3.00000, 3.90000, 2),
+       (45, 49, 120, 139, 4.00000, 4.90000, 2),
+       (45, 49, 120, 139, 5.00000, 5.90000, 2),
+       (45, 49, 120, 139, 6.00000, 6.90000, 2),
+       (45, 49, 100, 119, 3.00000, 3.90000, 1),
+       (45, 49, 100, 119, 4.00000, 4.90000, 1),
+       (45, 49, 100, 119, 5.00000, 5.90000, 2),
+       (45, 49, 100, 119, 6.00000, 6.90000, 2),
+       (40, 44, 160, 179, 3.00000, 3.90000, 2),
+       (40, 44, 160, 179, 4.00000, 4.90000, 2),
+       (40, 44, 160, 179, 5.00000, 5.90000, 3),
+       (40, 44, 160, 179, 6.00000, 6.90000, 3),
+       (40, 44, 140, 159, 3.00000, 3.90000, 2),
+       (40, 44, 140, 159, 4.00000, 4.90000, 2),
+       (40, 44, 140, 159, 5.00000, 5.90000, 2),
+       (40, 44, 140, 159, 6.00000, 6.90000, 2),
+       (40, 44, 120, 139, 3.00000, 3.90000, 1),
+       (40, 44, 120, 139, 4.00000, 4.90000, 1),
+       (40, 44, 120, 139, 5.00000, 5.90000, 1),
+       (40, 44, 120, 139, 6.00000, 6.90000, 2),
+       (40, 44, 100, 119, 3.00000, 3.90000, 1),
+       (40, 44, 100, 119, 4.00000, 4.90000, 1),
+       (40, 44, 100, 119, 5.00000, 5.90000, 1),
+       (40, 44, 100, 119, 6.00000, 6.90000, 1);
+
+INSERT INTO moderate_risk_female_smoking
+VALUES (85, 89, 160, 179, 3.00000, 3.90000, 42),
+       (85, 89, 160, 179, 4.00000, 4.90000, 43),
+       (85, 89, 160, 179, 5.00000, 5.90000, 45),
+       (85, 89, 160, 179, 6.00000, 6.90000, 47),
+       (85, 89, 140, 159, 3.00000, 3.90000, 39),
+       (85, 89, 140, 159, 4.00000, 4.90000, 40),
+       (85, 89, 140, 159, 5.00000, 5.90000, 42),
+       (85, 89, 140, 159, 6.00000, 6.90000, 44),
+       (85, 89, 120, 139, 3.00000, 3.90000, 36),
+       (85, 89, 120, 139, 4.00000, 4.90000, 37),
+       (85, 89, 120, 139, 5.00000, 5.90000, 39),
+       (85, 89, 120, 139, 6.00000, 6.90000, 41),
+       (85, 89, 100, 119, 3.00000, 3.90000, 33),
+       (85, 89, 100, 119, 4.00000, 4.90000, 35),
+       (85, 89, 100, 119, 5.00000, 5.90000, 36),
+       (85, 89, 100, 119, 6.00000, 6.90000, 38),
+       (80, 84, 160, 179, 3.00000, 3.90000, 34),
+       (80, 84, 160, 179, 4.00000, 4.90000, 36),
+       (80, 84, 160, 179, 5.00000, 5.90000, 38),
+       (80, 84, 160, 179, 6.00000, 6.90000, 39),
+       (80, 84, 140, 159, 3.00000, 3.90000, 31),
+       (80, 84, 140, 159, 4.00000, 4.90000, 32),
+       (80, 84, 140, 159, 5.00000, 5.90000, 34),
+       (80, 84, 140, 159, 6.00000, 6.90000, 35),
+       (80, 84, 120, 139, 3.00000, 3.90000, 27),
+       (80, 84, 120, 139, 4.00000, 4.90000, 29),
+       (80, 84, 120, 139, 5.00000, 5.90000, 30),
+       (80, 84, 120, 139, 6.00000, 6.90000, 31),
+       (80, 84, 100, 119, 3.00000, 3.90000, 24),
+       (80, 84, 100, 119, 4.00000, 4.90000, 25),
+       (80, 84, 100, 119, 5.00000, 5.90000, 27),
+       (80, 84, 100, 119, 6.00000, 6.90000, 28),
+       (75, 79, 160, 179, 3.00000, 3.90000, 28),
+       (75, 79, 160, 179, 4.00000, 4.90000, 30),
+       (75, 79, 160, 179, 5.00000, 5.90000, 31),
+       (75, 79, 160, 179, 6.00000, 6.90000, 33),
+       (75, 79, 140, 159, 3.00000, 3.90000, 24),
+       (75, 79, 140, 159, 4.00000, 4.90000, 25),
+       (75, 79, 140, 159, 5.00000, 5.90000, 27),
+       (75, 79, 140, 159, 6.00000, 6.90000, 28),
+       (75, 79, 120, 139, 3.00000, 3.90000, 20),
+       (75, 79, 120, 139, 4.00000, 4.90000, 21),
+       (75, 79, 120, 139, 5.00000, 5.90000, 23),
+       (75, 79, 120, 139, 6.00000, 6.90000, 24),
+       (75, 79, 100, 119, 3.00000, 3.90000, 17),
+       (75, 79, 100, 119, 4.00000, 4.90000, 18),
+       (75, 79, 100, 119, 5.00000, 5.90000, 19),
+       (75, 79, 100, 119, 6.00000, 6.90000, 20),
+       (70, 74, 160, 179, 3.00000, 3.90000, 23),
+       (70, 74, 160, 179, 4.00000, 4.90000, 24),
+       (70, 74, 160, 179, 5.00000, 5.90000, 25),
+       (70, 74, 160, 179, 6.00000, 6.90000, 27),
+       (70, 74, 140, 159, 3.00000, 3.90000, 18),
+       (70, 74, 140, 159, 4.00000, 4.90000, 20),
+       (70, 74, 140, 159, 5.00000, 5.90000, 21),
+       (70, 74, 140, 159, 6.00000, 6.90000, 22),
+       (70, 74, 120, 139, 3.00000, 3.90000, 15),
+       (70, 74, 120, 139, 4.00000, 4.90000, 16),
+       (70, 74, 120, 139, 5.00000, 5.90000, 17),
+       (70, 74, 120, 139, 6.00000, 6.90000, 18),
+       (70, 74, 100, 119, 3.00000, 3.90000, 12),
+       (70, 74, 100, 119, 4.00000, 4.90000, 13),
+       (70, 74, 100, 119, 5.00000, 5.90000, 14),
+       (70, 74, 100, 119, 6.00000, 6.90000, 15),
+       (65, 69, 160, 179, 3.00000, 3.90000, 16),
+       (65, 69, 160, 179, 4.00000, 4.90000, 17),
+       (65, 69, 160, 179, 5.00000, 5.90000, 17),
+       (65, 69, 160, 179, 6.00000, 6.90000, 18),
+       (65, 69, 140, 159, 3.00000, 3.90000, 13),
+       (65, 69, 140, 159, 4.00000, 4.90000, 14),
+       (65, 69, 140, 159, 5.00000, 5.90000, 14),
+       (65, 69, 140, 159, 6.00000, 6.90000, 15),
+       (65, 69, 120, 139, 3.00000, 3.90000, 11),
+       (65, 69, 120, 139, 4.00000, 4.90000, 11),
+       (65, 69, 120, 139, 5.00000, 5.90000, 12),
+       (65, 69, 120, 139, 6.00000, 6.90000, 12),
+       (65, 69, 100, 119, 3.00000, 3.90000, 9),
+       (65, 69, 100, 119, 4.00000, 4.90000, 9),
+       (65, 69, 100, 119, 5.00000, 5.90000, 10),
+       (65, 69, 100, 119, 6.00000, 6.90000, 10),
+       (60, 64, 160, 179, 3.00000, 3.90000, 13),
+       (60, 64, 160, 179, 4.00000, 4.90000, 13),
+       (60, 64, 160, 179, 5.00000, 5.90000, 14),
+       (60, 64, 160, 179, 6.00000, 6.90000, 15),
+       (60, 64, 140, 159, 3.00000, 3.90000, 10),
+       (60, 64, 140, 159, 4.00000, 4.90000, 11),
+       (60, 64, 140, 159, 5.00000, 5.90000, 12),
+       (60, 64, 140, 159, 6.00000, 6.90000, 12),
+       (60, 64, 120, 139, 3.00000, 3.90000, 8),
+       (60, 64, 120, 139, 4.00000, 4.90000, 9),
+       (60, 64, 120, 139, 5.00000, 5.90000, 9),
+       (60, 64, 120, 139, 6.00000, 6.90000, 10),
+       (60, 64, 100, 119, 3.00000, 3.90000, 7),
+       (60, 64, 100, 119, 4.00000, 4.90000, 7),
+       (60, 64, 100, 119, 5.00000, 5.90000, 7),
+       (60, 64, 100, 119, 6.00000, 6.90000, 8),
+       (55, 59, 160, 179, 3.00000, 3.90000, 10),
+       (55, 59, 160, 179, 4.00000, 4.90000, 11),
+       (55, 59, 160, 179, 5.00000, 5.90000, 12),
+       (55, 59, 160, 179, 6.00000, 6.90000, 13),
+       (55, 59, 140, 159, 3.00000, 3.90000, 8),
+       (55, 59, 140, 159, 4.00000, 4.90000, 9),
+       (55, 59, 140, 159, 5.00000, 5.90000, 9),
+       (55, 59, 140, 159, 6.00000, 6.90000, 10),
+       (55, 59, 120, 139, 3.00000, 3.90000, 6),
+       (55, 59, 120, 139, 4.00000, 4.90000, 7),
+       (55, 59, 120, 139, 5.00000, 5.90000, 7),
+       (55, 59, 120, 139, 6.00000, 6.90000, 8),
+       (55, 59, 100, 119, 3.00000, 3.90000, 5),
+       (55, 59, 100, 119, 4.00000, 4.90000, 5),
+       (55, 59, 100, 119, 5.00000, 5.90000, 6),
+       (55, 59, 100, 119, 6.00000, 6.90000, 6),
+       (50, 54, 160, 179, 3.00000, 3.90000, 8),
+       (50, 54, 160, 179, 4.00000, 4.90000, 9),
+       (50, 54, 160, 179, 5.00000, 5.90000, 10),
+       (50, 54, 160, 179, 6.00000, 6.90000, 11),
+       (50, 54, 140, 159, 3.00000, 3.90000, 6),
+       (50, 54, 140, 159, 4.00000, 4.90000, 7),
+       (50, 54, 140, 159, 5.00000, 5.90000, 7),
+       (50, 54, 140, 159, 6.00000, 6.90000, 8),
+       (50, 54, 120, 139, 3.00000, 3.90000, 5),
+       (50, 54, 120, 139, 4.00000, 4.90000, 5),
+       (50, 54, 120, 139, 5.00000, 5.90000, 6),
+       (50, 54, 120, 139, 6.00000, 6.90000, 6),
+       (50, 54, 100, 119, 3.00000, 3.90000, 4),
+       (50, 54, 100, 119, 4.00000, 4.90000, 4),
+       (50, 54, 100, 119, 5.00000, 5.90000, 4),
+       (50, 54, 100, 119, 6.00000, 6.90000, 5),
+       (45, 49, 160, 179, 3.00000, 3.90000, 6),
+       (45, 49, 160, 179, 4.00000, 4.90000, 7),
+       (45, 49, 160, 179, 5.00000, 5.90000, 8),
+       (45, 49, 160, 179, 6.00000, 6.90000, 9),
+       (45, 49, 140, 159, 3.00000, 3.90000, 5),
+       (45, 49, 140, 159, 4.00000, 4.90000, 5),
+       (45, 49, 140, 159, 5.00000, 5.90000, 6),
+       (45, 49, 140, 159, 6.00000, 6.90000, 7),
+       (45, 49, 120, 139, 3.00000, 3.90000, 4),
+       (45, 49, 120, 139, 4.00000, 4.90000, 4),
+       (45, 49, 120, 139, 5.00000, 5.90000, 5),
+       (45, 49, 120, 139, 6.00000, 6.90000, 5),
+       (45, 49, 100, 119, 3.00000, 3.90000, 3),
+       (45, 49, 100, 119, 4.00000, 4.90000, 3),
+       (45, 49, 100, 119, 5.00000, 5.90000, 3),
+       (45, 49, 100, 119, 6.00000, 6.90000, 4),
+       (40, 44, 160, 179, 3.00000, 3.90000, 5),
+       (40, 44, 160, 179, 4.00000, 4.90000, 6),
+       (40, 44, 160, 179, 5.00000, 5.90000, 7),
+       (40, 44, 160, 179, 6.00000, 6.90000, 7),
+       (40, 44, 140, 159, 3.00000, 3.90000, 4),
+       (40, 44, 140, 159, 4.00000, 4.90000, 4),
+       (40, 44, 140, 159, 5.00000, 5.90000, 5),
+       (40, 44, 140, 159, 6.00000, 6.90000, 6),
+       (40, 44, 120, 139, 3.00000, 3.90000, 3),
+       (40, 44, 120, 139, 4.00000, 4.90000, 3),
+       (40, 44, 120, 139, 5.00000, 5.90000, 4),
+       (40, 44, 120, 139, 6.00000, 6.90000, 4),
+       (40, 44, 100, 119, 3.00000, 3.90000, 2),
+       (40, 44, 100, 119, 4.00000, 4.90000, 2),
+       (40, 44, 100, 119, 5.00000, 5.90000, 3),
+       (40, 44, 100, 119, 6.00000, 6.90000, 3);
+
+INSERT INTO moderate_risk_male_not_smoking
+VALUES (85, 89, 160, 179, 3.00000, 3.90000, 37),
+       (85, 89, 160, 179, 4.00000, 4.90000, 45),
+       (85, 89, 160, 179, 5.00000, 5.90000, 53),
+       (85, 89, 160, 179, 6.00000, 6.90000, 61),
+       (85, 89, 140, 159, 3.00000, 3.90000, 36),
+       (85, 89, 140, 159, 4.00000, 4.90000, 43),
+       (85, 89, 140, 159, 5.00000, 5.90000, 51),
+       (85, 89, 140, 159, 6.00000, 6.90000, 59),
+       (85, 89, 120, 139, 3.00000, 3.90000, 34),
+       (85, 89, 120, 139, 4.00000, 4.90000, 41),
+       (85, 89, 120, 139, 5.00000, 5.90000, 49),
+       (85, 89, 120, 139, 6.00000, 6.90000, 58),
+       (85, 89, 100, 119, 3.00000, 3.90000, 33),
+       (85, 89, 100, 119, 4.00000, 4.90000, 40),
+       (85, 89, 100, 119, 5.00000, 5.90000, 47),
+       (85, 89, 100, 119, 6.00000, 6.90000, 56),
+       (80, 84, 160, 179, 3.00000, 3.90000, 30),
+       (80, 84, 160, 179, 4.00000, 4.90000, 35),
+       (80, 84, 160, 179, 5.00000, 5.90000, 41),
+       (80, 84, 160, 179, 6.00000, 6.90000, 47),
+       (80, 84, 140, 159, 3.00000, 3.90000, 27),
+       (80, 84, 140, 159, 4.00000, 4.90000, 32),
+       (80, 84, 140, 159, 5.00000, 5.90000, 37),
+       (80, 84, 140, 159, 6.00000, 6.90000, 43),
+       (80, 84, 120, 139, 3.00000, 3.90000, 25),
+       (80, 84, 120, 139, 4.00000, 4.90000, 29),
+       (80, 84, 120, 139, 5.00000, 5.90000, 34),
+       (80, 84, 120, 139, 6.00000, 6.90000, 39),
+       (80, 84, 100, 119, 3.00000, 3.90000, 22),
+       (80, 84, 100, 119, 4.00000, 4.90000, 26),
+       (80, 84, 100, 119, 5.00000, 5.90000, 31),
+       (80, 84, 100, 119, 6.00000, 6.90000, 36),
+       (75, 79, 160, 179, 3.00000, 3.90000, 24),
+       (75, 79, 160, 179, 4.00000, 4.90000, 27),
+       (75, 79, 160, 179, 5.00000, 5.90000, 30),
+       (75, 79, 160, 179, 6.00000, 6.90000, 34),
+       (75, 79, 140, 159, 3.00000, 3.90000, 20),
+       (75, 79, 140, 159, 4.00000, 4.90000, 23),
+       (75, 79, 140, 159, 5.00000, 5.90000, 26),
+       (75, 79, 140, 159, 6.00000, 6.90000, 30),
+       (75, 79, 120, 139, 3.00000, 3.90000, 17),
+       (75, 79, 120, 139, 4.00000, 4.90000, 20),
+       (75, 79, 120, 139, 5.00000, 5.90000, 22),
+       (75, 79, 120, 139, 6.00000, 6.90000, 25),
+       (75, 79, 100, 119, 3.00000, 3.90000, 15),
+       (75, 79, 100, 119, 4.00000, 4.90000, 17),
+       (75, 79, 100, 119, 5.00000, 5.90000, 19),
+       (75, 79, 100, 119, 6.00000, 6.90000, 22),
+       (70, 74, 160, 179, 3.00000, 3.90000, 19),
+       (70, 74, 160, 179, 4.00000, 4.90000, 20),
+       (70, 74, 160, 179, 5.00000, 5.90000, 22),
+       (70, 74, 160, 179, 6.00000, 6.90000, 24),
+       (70, 74, 140, 159, 3.00000, 3.90000, 15),
+       (70, 74, 140, 159, 4.00000, 4.90000, 16),
+       (70, 74, 140, 159, 5.00000, 5.90000, 18),
+       (70, 74, 140, 159, 6.00000, 6.90000, 20),
+       (70, 74, 120, 139, 3.00000, 3.90000, 12),
+       (70, 74, 120, 139, 4.00000, 4.90000, 13),
+       (70, 74, 120, 139, 5.00000, 5.90000, 14),
+       (70, 74, 120, 139, 6.00000, 6.90000, 16),
+       (70, 74, 100, 119, 3.00000, 3.90000, 9),
+       (70, 74, 100, 119, 4.00000, 4.90000, 10),
+       (70, 74, 100, 119, 5.00000, 5.90000, 11),
+       (70, 74, 100, 119, 6.00000, 6.90000, 13),
+       (65, 69, 160, 179, 3.00000, 3.90000, 14),
+       (65, 69, 160, 179, 4.00000, 4.90000, 15),
+       (65, 69, 160, 179, 5.00000, 5.90000, 16),
+       (65, 69, 160, 179, 6.00000, 6.90000, 17),
+       (65, 69, 140, 159, 3.00000, 3.90000, 12),
+       (65, 69, 140, 159, 4.00000, 4.90000, 13),
+       (65, 69, 140, 159, 5.00000, 5.90000, 14),
+       (65, 69, 140, 159, 6.00000, 6.90000, 15),
+       (65, 69, 120, 139, 3.00000, 3.90000, 10),
+       (65, 69, 120, 139, 4.00000, 4.90000, 10),
+       (65, 69, 120, 139, 5.00000, 5.90000, 11),
+       (65, 69, 120, 139, 6.00000, 6.90000, 12),
+       (65, 69, 100, 119, 3.00000, 3.90000, 8),
+       (65, 69, 100, 119, 4.00000, 4.90000, 9),
+       (65, 69, 100, 119, 5.00000, 5.90000, 9),
+       (65, 69, 100, 119, 6.00000, 6.90000, 10),
+       (60, 64, 160, 179, 3.00000, 3.90000, 11),
+       (60, 64, 160, 179, 4.00000, 4.90000, 12),
+       (60, 64, 160, 179, 5.00000, 5.90000, 13),
+       (60, 64, 160, 179, 6.00000, 6.90000, 14),
+       (60, 64, 140, 159, 3.00000, 3.90000, 9),
+       (60, 64, 140, 159, 4.00000, 4.90000, 10),
+       (60, 64, 140, 159, 5.00000, 5.90000, 11),
+       (60, 64, 140, 159, 6.00000, 6.90000, 12),
+       (60, 64, 120, 139, 3.00000, 3.90000, 7),
+       (60, 64, 120, 139, 4.00000, 4.90000, 8),
+       (60, 64, 120, 139, 5.00000, 5.90000, 9),
+       (60, 64, 120, 139, 6.00000, 6.90000, 10),
+       (60, 64, 100, 119, 3.00000, 3.90000, 6),
+       (60, 64, 100, 119, 4.00000, 4.90000, 6),
+       (60, 64, 100, 119, 5.00000, 5.90000, 7),
+       (60, 64, 100, 119, 6.00000, 6.90000, 8),
+       (55, 59, 160, 179, 3.00000, 3.90000, 8),
+       (55, 59, 160, 179, 4.00000, 4.90000, 9),
+       (55, 59, 160, 179, 5.00000, 5.90000, 11),
+       (55, 59, 160, 179, 6.00000, 6.90000, 12),
+       (55, 59, 140, 159, 3.00000, 3.90000, 7),
+       (55, 59, 140, 159, 4.00000, 4.90000, 7),
+       (55, 59, 140, 159, 5.00000, 5.90000, 8),
+       (55, 59, 140, 159, 6.00000, 6.90000, 10),
+       (55, 59, 120, 139, 3.00000, 3.90000, 5),
+       (55, 59, 120, 139, 4.00000, 4.90000, 6),
+       (55, 59, 120, 139, 5.00000, 5.90000, 7),
+       (55, 59, 120, 139, 6.00000, 6.90000, 8),
+       (55, 59, 100, 119, 3.00000, 3.90000, 4),
+       (55, 59, 100, 119, 4.00000, 4.90000, 5),
+       (55, 59, 100, 119, 5.00000, 5.90000, 5),
+       (55, 59, 100, 119, 6.00000, 6.90000, 6),
+       (50, 54, 160, 179, 3.00000, 3.90000, 6),
+       (50, 54, 160, 179, 4.00000, 4.90000, 7),
+       (50, 54, 160, 179, 5.00000, 5.90000, 8),
+       (50, 54, 160, 179, 6.00000, 6.90000, 10),
+       (50, 54, 140, 159, 3.00000, 3.90000, 5),
+       (50, 54, 140, 159, 4.00000, 4.90000, 6),
+       (50, 54, 140, 159, 5.00000, 5.90000, 7),
+       (50, 54, 140, 159, 6.00000, 6.90000, 8),
+       (50, 54, 120, 139, 3.00000, 3.90000, 4),
+       (50, 54, 120, 139, 4.00000, 4.90000, 4),
+       (50, 54, 120, 139, 5.00000, 5.90000, 5),
+       (50, 54, 120, 139, 6.00000, 6.90000, 6),
+       (50, 54, 100, 119, 3.00000, 3.90000, 3),
+       (50, 54, 100, 119, 4.00000, 4.90000, 3),
+       (50, 54, 100, 119, 5.00000, 5.90000, 4),
+       (50, 54, 100, 119, 6.00000, 6.90000, 5),
+       (45, 49, 160, 179, 3.00000, 3.90000, 5),
+       (45, 49, 160, 179, 4.00000, 4.90000, 6),
+       (45, 49, 160, 179, 5.00000, 5.90000, 7),
+       (45, 49, 160, 179, 6.00000, 6.90000, 8),
+       (45, 49, 140, 159, 3.00000, 3.90000, 4),
+       (45, 49, 140, 159, 4.00000, 4.90000, 4),
+       (45, 49, 140, 159, 5.00000, 5.90000, 5),
+       (45, 49, 140, 159, 6.00000, 6.90000, 6),
+       (45, 49, 120, 139, 3.00000, 3.90000, 3),
+       (45, 49, 120, 139, 4.00000, 4.90000, 3),
+       (45, 49, 120, 139, 5.00000, 5.90000, 4),
+       (45, 49, 120, 139, 6.00000, 6.90000, 5),
+       (45, 49, 100, 119, 3.00000, 3.90000, 2),
+       (45, 49, 100, 119, 4.00000, 4.90000, 3),
+       (45, 49, 100, 119, 5.00000, 5.90000, 3),
+       (45, 49, 100, 119, 6.00000, 6.90000, 4),
+       (40, 44, 160, 179, 3.00000, 3.90000, 4),
+       (40, 44, 160, 179, 4.00000, 4.90000, 4),
+       (40, 44, 160, 179, 5.00000, 5.90000, 5),
+       (40, 44, 160, 179, 6.00000, 6.90000, 7),
+       (40, 44, 140, 159, 3.00000, 3.90000, 3),
+       (40, 44, 140, 159, 4.00000, 4.90000, 3),
+       (40, 44, 140, 159, 5.00000, 5.90000, 4),
+       (40, 44, 140, 159, 6.00000, 6.90000, 5),
+       (40, 44, 120, 139, 3.00000, 3.90000, 2),
+       (40, 44, 120, 139, 4.00000, 4.90000, 3),
+       (40, 44, 120, 139, 5.00000, 5.90000, 3),
+       (40, 44, 120, 139, 6.00000, 6.90000, 4),
+       (40, 44, 100, 119, 3.00000, 3.90000, 2),
+       (40, 44, 100, 119, 4.00000, 4.90000, 2),
+       (40, 44, 100, 119, 5.00000, 5.90000, 2),
+       (40, 44, 100, 119, 6.00000, 6.90000, 3);
+
+INSERT INTO moderate_risk_male_smoking
+VALUES (85, 89, 160, 179, 3.00000, 3.90000, 37),
+       (85, 89, 160, 179, 4.00000, 4.90000, 44),
+       (85, 89, 160, 179, 5.00000, 5.90000, 53),
+       (85, 89, 160, 179, 6.00000, 6.90000, 61),
+       (85, 89, 140, 159, 3.00000, 3.90000, 36),
+       (85, 89, 140, 159, 4.00000, 4.90000, 43),
+       (85, 89, 140, 159, 5.00000, 5.90000, 51),
+       (85, 89, 140, 159, 6.00000, 6.90000, 59),
+       (85, 89, 120, 139, 3.00000, 3.90000, 34),
+       (85, 89, 120, 139, 4.00000, 4.90000, 41),
+       (85, 89, 120, 139, 5.00000, 5.90000, 49),
+       (85, 89, 120, 139, 6.00000, 6.90000, 57),
+       (85, 89, 100, 119, 3.00000, 3.90000, 33),
+       (85, 89, 100, 119, 4.00000, 4.90000, 39),
+       (85, 89, 100, 119, 5.00000, 5.90000, 47),
+       (85, 89, 100, 119, 6.00000, 6.90000, 55),
+       (80, 84, 160, 179, 3.00000, 3.90000, 34),
+       (80, 84, 160, 179, 4.00000, 4.90000, 39),
+       (80, 84, 160, 179, 5.00000, 5.90000, 45),
+       (80, 84, 160, 179, 6.00000, 6.90000, 52),
+       (80, 84, 140, 159, 3.00000, 3.90000, 31),
+       (80, 84, 140, 159, 4.00000, 4.90000, 36),
+       (80, 84, 140, 159, 5.00000, 5.90000, 42),
+       (80, 84, 140, 159, 6.00000, 6.90000, 48),
+       (80, 84, 120, 139, 3.00000, 3.90000, 28),
+       (80, 84, 120, 139, 4.00000, 4.90000, 33),
+       (80, 84, 120, 139, 5.00000, 5.90000, 38),
+       (80, 84, 120, 139, 6.00000, 6.90000, 44),
+       (80, 84, 100, 119, 3.00000, 3.90000, 25),
+       (80, 84, 100, 119, 4.00000, 4.90000, 30),
+       (80, 84, 100, 119, 5.00000, 5.90000, 35),
+       (80, 84, 100, 119, 6.00000, 6.90000, 40),
+       (75, 79, 160, 179, 3.00000, 3.90000, 31),
+       (75, 79, 160, 179, 4.00000, 4.90000, 34),
+       (75, 79, 160, 179, 5.00000, 5.90000, 39),
+       (75, 79, 160, 179, 6.00000, 6.90000, 43),
+       (75, 79, 140, 159, 3.00000, 3.90000, 26),
+       (75, 79, 140, 159, 4.00000, 4.90000, 30),
+       (75, 79, 140, 159, 5.00000, 5.90000, 34),
+       (75, 79, 140, 159, 6.00000, 6.90000, 38),
+       (75, 79, 120, 139, 3.00000, 3.90000, 23),
+       (75, 79, 120, 139, 4.00000, 4.90000, 26),
+       (75, 79, 120, 139, 5.00000, 5.90000, 29),
+       (75, 79, 120, 139, 6.00000, 6.90000, 33),
+       (75, 79, 100, 119, 3.00000, 3.90000, 19),
+       (75, 79, 100, 119, 4.00000, 4.90000, 22),
+       (75, 79, 100, 119, 5.00000, 5.90000, 25),
+       (75, 79, 100, 119, 6.00000, 6.90000, 28),
+       (70, 74, 160, 179, 3.00000, 3.90000, 28),
+       (70, 74, 160, 179, 4.00000, 4.90000, 30),
+       (70, 74, 160, 179, 5.00000, 5.90000, 33),
+       (70, 74, 160, 179, 6.00000, 6.90000, 36),
+       (70, 74, 140, 159, 3.00000, 3.90000, 22),
+       (70, 74, 140, 159, 4.00000, 4.90000, 25),
+       (70, 74, 140, 159, 5.00000, 5.90000, 27),
+       (70, 74, 140, 159, 6.00000, 6.90000, 29),
+       (70, 74, 120, 139, 3.00000, 3.90000, 18),
+       (70, 74, 120, 139, 4.00000, 4.90000, 20),
+       (70, 74, 120, 139, 5.00000, 5.90000, 22),
+       (70, 74, 120, 139, 6.00000, 6.90000, 24),
+       (70, 74, 100, 119, 3.00000, 3.90000, 15),
+       (70, 74, 100, 119, 4.00000, 4.90000, 16),
+       (70, 74, 100, 119, 5.00000, 5.90000, 18),
+       (70, 74, 100, 119, 6.00000, 6.90000, 19),
+       (65, 69, 160, 179, 3.00000, 3.90000, 20),
+       (65, 69, 160, 179, 4.00000, 4.90000, 21),
+       (65, 69, 160, 179, 5.00000, 5.90000, 23),
+       (65, 69, 160, 179, 6.00000, 6.90000, 25),
+       (65, 69, 140, 159, 3.00000, 3.90000, 17),
+       (65, 69, 140, 159, 4.00000, 4.90000, 18),
+       (65, 69, 140, 159, 5.00000, 5.90000, 19),
+       (65, 69, 140, 159, 6.00000, 6.90000, 21),
+       (65, 69, 120, 139, 3.00000, 3.90000, 14),
+       (65, 69, 120, 139, 4.00000, 4.90000, 15),
+       (65, 69, 120, 139, 5.00000, 5.90000, 16),
+       (65, 69, 120, 139, 6.00000, 6.90000, 18),
+       (65, 69, 100, 119, 3.00000, 3.90000, 12),
+       (65, 69, 100, 119, 4.00000, 4.90000, 13),
+       (65, 69, 100, 119, 5.00000, 5.90000, 14),
+       (65, 69, 100, 119, 6.00000, 6.90000, 15),
+       (60, 64, 160, 179, 3.00000, 3.90000, 16),
+       (60, 64, 160, 179, 4.00000, 4.90000, 18),
+       (60, 64, 160, 179, 5.00000, 5.90000, 20),
+       (60, 64, 160, 179, 6.00000, 6.90000, 22),
+       (60, 64, 140, 159, 3.00000, 3.90000, 13),
+       (60, 64, 140, 159, 4.00000, 4.90000, 15),
+       (60, 64, 140, 159, 5.00000, 5.90000, 16),
+       (60, 64, 140, 159, 6.00000, 6.90000, 18),
+       (60, 64, 120, 139, 3.00000, 3.90000, 11),
+       (60, 64, 120, 139, 4.00000, 4.90000, 12),
+       (60, 64, 120, 139, 5.00000, 5.90000, 13),
+       (60, 64, 120, 139, 6.00000, 6.90000, 15),
+       (60, 64, 100, 119, 3.00000, 3.90000, 9),
+       (60, 64, 100, 119, 4.00000, 4.90000, 10),
+       (60, 64, 100, 119, 5.00000, 5.90000, 11),
+       (60, 64, 100, 119, 6.00000, 6.90000, 12),
+       (55, 59, 160, 179, 3.00000, 3.90000, 13),
+       (55, 59, 160, 179, 4.00000, 4.90000, 15),
+       (55, 59, 160, 179, 5.00000, 5.90000, 17),
+       (55, 59, 160, 179, 6.00000, 6.90000, 19),
+       (55, 59, 140, 159, 3.00000, 3.90000, 11),
+       (55, 59, 140, 159, 4.00000, 4.90000, 12),
+       (55, 59, 140, 159, 5.00000, 5.90000, 14),
+       (55, 59, 140, 159, 6.00000, 6.90000, 15),
+       (55, 59, 120, 139, 3.00000, 3.90000, 9),
+       (55, 59, 120, 139, 4.00000, 4.90000, 10),
+       (55, 59, 120, 139, 5.00000, 5.90000, 11),
+       (55, 59, 120, 139, 6.00000, 6.90000, 12),
+       (55, 59, 100, 119, 3.00000, 3.90000, 7),
+       (55, 59, 100, 119, 4.00000, 4.90000, 8),
+       (55, 59, 100, 119, 5.00000, 5.90000, 9),
+       (55, 59, 100, 119, 6.00000, 6.90000, 10),
+       (50, 54, 160, 179, 3.00000, 3.90000, 11),
+       (50, 54, 160, 179, 4.00000, 4.90000, 13),
+       (50, 54, 160, 179, 5.00000, 5.90000, 15),
+       (50, 54, 160, 179, 6.00000, 6.90000, 17),
+       (50, 54, 140, 159, 3.00000, 3.90000, 9),
+       (50, 54, 140, 159, 4.00000, 4.90000, 10),
+       (50, 54, 140, 159, 5.00000, 5.90000, 11),
+       (50, 54, 140, 159, 6.00000, 6.90000, 13),
+       (50, 54, 120, 139, 3.00000, 3.90000, 7),
+       (50, 54, 120, 139, 4.00000, 4.90000, 8),
+       (50, 54, 120, 139, 5.00000, 5.90000, 9),
+       (50, 54, 120, 139, 6.00000, 6.90000, 10),
+       (50, 54, 100, 119, 3.00000, 3.90000, 5),
+       (50, 54, 100, 119, 4.00000, 4.90000, 6),
+       (50, 54, 100, 119, 5.00000, 5.90000, 7),
+       (50, 54, 100, 119, 6.00000, 6.90000, 8),
+       (45, 49, 160, 179, 3.00000, 3.90000, 9),
+       (45, 49, 160, 179, 4.00000, 4.90000, 11),
+       (45, 49, 160, 179, 5.00000, 5.90000, 12),
+       (45, 49, 160, 179, 6.00000, 6.90000, 15),
+       (45, 49, 140, 159, 3.00000, 3.90000, 7),
+       (45, 49, 140, 159, 4.00000, 4.90000, 8),
+       (45, 49, 140, 159, 5.00000, 5.90000, 10),
+       (45, 49, 140, 159, 6.00000, 6.90000, 11),
+       (45, 49, 120, 139, 3.00000, 3.90000, 5),
+       (45, 49, 120, 139, 4.00000, 4.90000, 6),
+       (45, 49, 120, 139, 5.00000, 5.90000, 7),
+       (45, 49, 120, 139, 6.00000, 6.90000, 9),
+       (45, 49, 100, 119, 3.00000, 3.90000, 4),
+       (45, 49, 100, 119, 4.00000, 4.90000, 5),
+       (45, 49, 100, 119, 5.00000, 5.90000, 6),
+       (45, 49, 100, 119, 6.00000, 6.90000, 7),
+       (40, 44, 160, 179, 3.00000, 3.90000, 7),
+       (40, 44, 160, 179, 4.00000, 4.90000, 9),
+       (40, 44, 160, 179, 5.00000, 5.90000, 11),
+       (40, 44, 160, 179, 6.00000, 6.90000, 13),
+       (40, 44, 140, 159, 3.00000, 3.90000, 5),
+       (40, 44, 140, 159, 4.00000, 4.90000, 7),
+       (40, 44, 140, 159, 5.00000, 5.90000, 8),
+       (40, 44, 140, 159, 6.00000, 6.90000, 10),
+       (40, 44, 120, 139, 3.00000, 3.90000, 4),
+       (40, 44, 120, 139, 4.00000, 4.90000, 5),
+       (40, 44, 120, 139, 5.00000, 5.90000, 6),
+       (40, 44, 120, 139, 6.00000, 6.90000, 7),
+       (40, 44, 100, 119, 3.00000, 3.90000, 3),
+       (40, 44, 100, 119, 4.00000, 4.90000, 4),
+       (40, 44, 100, 119, 5.00000, 5.90000, 5),
+       (40, 44, 100, 119, 6.00000, 6.90000, 5);
+
+ALTER TABLE basic_indicators
+    ADD COLUMN IF NOT EXISTS risk_scope VARCHAR(255);
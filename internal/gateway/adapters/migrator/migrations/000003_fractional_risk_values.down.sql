@@ -0,0 +1,2 @@
+ALTER TABLE basic_indicators
+    ALTER COLUMN cv_events_risk_value TYPE INTEGER USING ROUND(cv_events_risk_value);
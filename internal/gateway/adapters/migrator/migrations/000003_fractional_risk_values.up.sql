@@ -0,0 +1,2 @@
+ALTER TABLE basic_indicators
+    ALTER COLUMN cv_events_risk_value TYPE DECIMAL(4, 1);
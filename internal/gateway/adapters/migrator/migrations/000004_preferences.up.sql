@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS preferences
+(
+    user_id     INTEGER      NOT NULL PRIMARY KEY,
+    unit_system VARCHAR(255) NOT NULL DEFAULT 'si'
+);
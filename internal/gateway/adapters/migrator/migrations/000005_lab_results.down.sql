@@ -0,0 +1,33 @@
+DROP VIEW IF EXISTS analyses_view;
+
+ALTER TABLE analyses
+    ADD COLUMN high_density_cholesterol            DECIMAL(4, 1),
+    ADD COLUMN low_density_cholesterol             DECIMAL(4, 1),
+    ADD COLUMN triglycerides                       DECIMAL(4, 1),
+    ADD COLUMN lipoprotein                         DECIMAL(4, 1),
+    ADD COLUMN highly_sensitive_c_reactive_protein DECIMAL(4, 1),
+    ADD COLUMN atherogenicity_coefficient          DECIMAL(4, 1),
+    ADD COLUMN creatinine                          DECIMAL(4, 1);
+
+UPDATE analyses a
+SET high_density_cholesterol            = r.high_density_cholesterol,
+    low_density_cholesterol             = r.low_density_cholesterol,
+    triglycerides                       = r.triglycerides,
+    lipoprotein                         = r.lipoprotein,
+    highly_sensitive_c_reactive_protein = r.highly_sensitive_c_reactive_protein,
+    atherogenicity_coefficient          = r.atherogenicity_coefficient,
+    creatinine                          = r.creatinine
+FROM (SELECT analysis_id,
+             MAX(value) FILTER (WHERE test_code = 'highDensityCholesterol')          AS high_density_cholesterol,
+             MAX(value) FILTER (WHERE test_code = 'lowDensityCholesterol')           AS low_density_cholesterol,
+             MAX(value) FILTER (WHERE test_code = 'triglycerides')                   AS triglycerides,
+             MAX(value) FILTER (WHERE test_code = 'lipoprotein')                     AS lipoprotein,
+             MAX(value) FILTER (WHERE test_code = 'highlySensitiveCReactiveProtein') AS highly_sensitive_c_reactive_protein,
+             MAX(value) FILTER (WHERE test_code = 'atherogenicityCoefficient')       AS atherogenicity_coefficient,
+             MAX(value) FILTER (WHERE test_code = 'creatinine')                      AS creatinine
+      FROM lab_results
+      GROUP BY analysis_id) r
+WHERE r.analysis_id = a.id;
+
+DROP TABLE IF EXISTS lab_results;
+DROP TABLE IF EXISTS lab_tests;
@@ -0,0 +1,94 @@
+CREATE TABLE IF NOT EXISTS lab_tests
+(
+    code       VARCHAR(255) PRIMARY KEY,
+    name       VARCHAR(255)            NOT NULL,
+    unit       VARCHAR(255)            NOT NULL DEFAULT '',
+    value_min  DECIMAL(10, 3),
+    value_max  DECIMAL(10, 3),
+    built_in   BOOLEAN                 NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+INSERT INTO lab_tests (code, name, unit, value_min, value_max, built_in)
+VALUES ('highDensityCholesterol', 'Холестерин высокой плотности (ЛПВП)', 'mmol/L', 0.5, 5.5, TRUE),
+       ('lowDensityCholesterol', 'Холестерин низкой плотности (ЛПНП)', 'mmol/L', 0.5, 8.5, TRUE),
+       ('triglycerides', 'Триглицериды', 'mmol/L', 0.2, 8.5, TRUE),
+       ('lipoprotein', 'Липопротеин (a)', 'g/L', 0.0, 10.0, TRUE),
+       ('highlySensitiveCReactiveProtein', 'Высокочувствительный С-реактивный белок', 'mg/L', 0.1, 12.0, TRUE),
+       ('atherogenicityCoefficient', 'Коэффициент атерогенности', '', 0.1, 8.0, TRUE),
+       ('creatinine', 'Креатинин', 'umol/L', 20.0, 500.0, TRUE),
+       ('glucose', 'Глюкоза', 'mmol/L', 1.0, 35.0, FALSE),
+       ('glycatedHemoglobin', 'Гликированный гемоглобин (HbA1c)', '%', 3.0, 20.0, FALSE),
+       ('alanineAminotransferase', 'Аланинаминотрансфераза (АЛТ)', 'U/L', 1.0, 1000.0, FALSE),
+       ('potassium', 'Калий', 'mmol/L', 1.5, 10.0, FALSE)
+ON CONFLICT DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS lab_results
+(
+    id           SERIAL PRIMARY KEY,
+    user_id      INTEGER                 NOT NULL,
+    analysis_id  INTEGER                 NOT NULL REFERENCES analyses (id) ON DELETE CASCADE,
+    test_code    VARCHAR(255)            NOT NULL REFERENCES lab_tests (code),
+    unit         VARCHAR(255)            NOT NULL DEFAULT '',
+    value        DECIMAL(10, 3)          NOT NULL,
+    collected_at TIMESTAMP DEFAULT NOW() NOT NULL,
+    UNIQUE (analysis_id, test_code)
+);
+
+CREATE INDEX IF NOT EXISTS lab_results_user_id_test_code_idx ON lab_results (user_id, test_code, collected_at);
+
+INSERT INTO lab_results (user_id, analysis_id, test_code, unit, value, collected_at)
+SELECT user_id, id, 'highDensityCholesterol', 'mmol/L', high_density_cholesterol, created_at
+FROM analyses
+WHERE high_density_cholesterol IS NOT NULL
+UNION ALL
+SELECT user_id, id, 'lowDensityCholesterol', 'mmol/L', low_density_cholesterol, created_at
+FROM analyses
+WHERE low_density_cholesterol IS NOT NULL
+UNION ALL
+SELECT user_id, id, 'triglycerides', 'mmol/L', triglycerides, created_at
+FROM analyses
+WHERE triglycerides IS NOT NULL
+UNION ALL
+SELECT user_id, id, 'lipoprotein', 'g/L', lipoprotein, created_at
+FROM analyses
+WHERE lipoprotein IS NOT NULL
+UNION ALL
+SELECT user_id, id, 'highlySensitiveCReactiveProtein', 'mg/L', highly_sensitive_c_reactive_protein, created_at
+FROM analyses
+WHERE highly_sensitive_c_reactive_protein IS NOT NULL
+UNION ALL
+SELECT user_id, id, 'atherogenicityCoefficient', '', atherogenicity_coefficient, created_at
+FROM analyses
+WHERE atherogenicity_coefficient IS NOT NULL
+UNION ALL
+SELECT user_id, id, 'creatinine', 'umol/L', creatinine, created_at
+FROM analyses
+WHERE creatinine IS NOT NULL;
+
+-- the analyses become the lab results groups (panels), the values are stored in the lab_results table
+ALTER TABLE analyses
+    DROP COLUMN high_density_cholesterol,
+    DROP COLUMN low_density_cholesterol,
+    DROP COLUMN triglycerides,
+    DROP COLUMN lipoprotein,
+    DROP COLUMN highly_sensitive_c_reactive_protein,
+    DROP COLUMN atherogenicity_coefficient,
+    DROP COLUMN creatinine;
+
+-- compatibility view with the former analyses columns
+CREATE OR REPLACE VIEW analyses_view AS
+SELECT a.id,
+       a.user_id,
+       MAX(r.value) FILTER (WHERE r.test_code = 'highDensityCholesterol')          AS high_density_cholesterol,
+       MAX(r.value) FILTER (WHERE r.test_code = 'lowDensityCholesterol')           AS low_density_cholesterol,
+       MAX(r.value) FILTER (WHERE r.test_code = 'triglycerides')                   AS triglycerides,
+       MAX(r.value) FILTER (WHERE r.test_code = 'lipoprotein')                     AS lipoprotein,
+       MAX(r.value) FILTER (WHERE r.test_code = 'highlySensitiveCReactiveProtein') AS highly_sensitive_c_reactive_protein,
+       MAX(r.value) FILTER (WHERE r.test_code = 'atherogenicityCoefficient')       AS atherogenicity_coefficient,
+       MAX(r.value) FILTER (WHERE r.test_code = 'creatinine')                      AS creatinine,
+       a.atherosclerotic_plaques_presence,
+       a.created_at
+FROM analyses a
+         LEFT JOIN lab_results r ON r.analysis_id = a.id
+GROUP BY a.id;
@@ -0,0 +1,24 @@
+DROP TABLE IF EXISTS basic_indicators_history;
+DROP TABLE IF EXISTS analyses_history;
+
+DROP VIEW IF EXISTS analyses_view;
+CREATE VIEW analyses_view AS
+SELECT a.id,
+       a.user_id,
+       MAX(r.value) FILTER (WHERE r.test_code = 'highDensityCholesterol')          AS high_density_cholesterol,
+       MAX(r.value) FILTER (WHERE r.test_code = 'lowDensityCholesterol')           AS low_density_cholesterol,
+       MAX(r.value) FILTER (WHERE r.test_code = 'triglycerides')                   AS triglycerides,
+       MAX(r.value) FILTER (WHERE r.test_code = 'lipoprotein')                     AS lipoprotein,
+       MAX(r.value) FILTER (WHERE r.test_code = 'highlySensitiveCReactiveProtein') AS highly_sensitive_c_reactive_protein,
+       MAX(r.value) FILTER (WHERE r.test_code = 'atherogenicityCoefficient')       AS atherogenicity_coefficient,
+       MAX(r.value) FILTER (WHERE r.test_code = 'creatinine')                      AS creatinine,
+       a.atherosclerotic_plaques_presence,
+       a.created_at
+FROM analyses a
+         LEFT JOIN lab_results r ON r.analysis_id = a.id
+GROUP BY a.id;
+
+ALTER TABLE basic_indicators
+    DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE analyses
+    DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,44 @@
+ALTER TABLE analyses
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE basic_indicators
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+CREATE OR REPLACE VIEW analyses_view AS
+SELECT a.id,
+       a.user_id,
+       MAX(r.value) FILTER (WHERE r.test_code = 'highDensityCholesterol')          AS high_density_cholesterol,
+       MAX(r.value) FILTER (WHERE r.test_code = 'lowDensityCholesterol')           AS low_density_cholesterol,
+       MAX(r.value) FILTER (WHERE r.test_code = 'triglycerides')                   AS triglycerides,
+       MAX(r.value) FILTER (WHERE r.test_code = 'lipoprotein')                     AS lipoprotein,
+       MAX(r.value) FILTER (WHERE r.test_code = 'highlySensitiveCReactiveProtein') AS highly_sensitive_c_reactive_protein,
+       MAX(r.value) FILTER (WHERE r.test_code = 'atherogenicityCoefficient')       AS atherogenicity_coefficient,
+       MAX(r.value) FILTER (WHERE r.test_code = 'creatinine')                      AS creatinine,
+       a.atherosclerotic_plaques_presence,
+       a.created_at,
+       a.deleted_at
+FROM analyses a
+         LEFT JOIN lab_results r ON r.analysis_id = a.id
+GROUP BY a.id;
+
+-- the previous values of the records, the record column contains the JSON representation of the record
+CREATE TABLE IF NOT EXISTS analyses_history
+(
+    id          SERIAL PRIMARY KEY,
+    analysis_id INTEGER                 NOT NULL REFERENCES analyses (id) ON DELETE CASCADE,
+    user_id     INTEGER                 NOT NULL,
+    record      JSONB                   NOT NULL,
+    changed_at  TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS analyses_history_analysis_id_idx ON analyses_history (analysis_id);
+
+CREATE TABLE IF NOT EXISTS basic_indicators_history
+(
+    id                  SERIAL PRIMARY KEY,
+    basic_indicators_id INTEGER                 NOT NULL REFERENCES basic_indicators (id) ON DELETE CASCADE,
+    user_id             INTEGER                 NOT NULL,
+    record              JSONB                   NOT NULL,
+    changed_at          TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS basic_indicators_history_basic_indicators_id_idx ON basic_indicators_history (basic_indicators_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS blood_pressure_measurements;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS blood_pressure_measurements
+(
+    id          SERIAL PRIMARY KEY,
+    user_id     INTEGER                 NOT NULL,
+    systolic    SMALLINT                NOT NULL,
+    diastolic   SMALLINT                NOT NULL,
+    pulse       SMALLINT,
+    arm         VARCHAR(255) NOT NULL DEFAULT '',
+    position    VARCHAR(255) NOT NULL DEFAULT '',
+    time_of_day VARCHAR(255) NOT NULL DEFAULT '',
+    measured_at TIMESTAMP               NOT NULL,
+    created_at  TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS blood_pressure_measurements_user_id_measured_at_idx ON blood_pressure_measurements (user_id, measured_at);
@@ -0,0 +1,4 @@
+ALTER TABLE blood_pressure_measurements
+    DROP COLUMN IF EXISTS hypertension_category;
+ALTER TABLE basic_indicators
+    DROP COLUMN IF EXISTS hypertension_category;
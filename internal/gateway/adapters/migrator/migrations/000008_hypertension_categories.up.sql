@@ -0,0 +1,27 @@
+ALTER TABLE basic_indicators
+    ADD COLUMN IF NOT EXISTS hypertension_category VARCHAR(255);
+ALTER TABLE blood_pressure_measurements
+    ADD COLUMN IF NOT EXISTS hypertension_category VARCHAR(255) NOT NULL DEFAULT '';
+
+-- the basic indicators have the systolic pressure only
+UPDATE basic_indicators
+SET hypertension_category = CASE
+                                WHEN sbp_level < 120 THEN 'optimal'
+                                WHEN sbp_level < 130 THEN 'normal'
+                                WHEN sbp_level < 140 THEN 'highNormal'
+                                WHEN sbp_level < 160 THEN 'grade1'
+                                WHEN sbp_level < 180 THEN 'grade2'
+                                ELSE 'grade3'
+    END
+WHERE sbp_level IS NOT NULL;
+
+UPDATE blood_pressure_measurements
+SET hypertension_category = CASE
+                                WHEN systolic >= 140 AND diastolic < 90 THEN 'isolatedSystolic'
+                                WHEN systolic >= 180 OR diastolic >= 110 THEN 'grade3'
+                                WHEN systolic >= 160 OR diastolic >= 100 THEN 'grade2'
+                                WHEN systolic >= 140 OR diastolic >= 90 THEN 'grade1'
+                                WHEN systolic >= 130 OR diastolic >= 85 THEN 'highNormal'
+                                WHEN systolic >= 120 OR diastolic >= 80 THEN 'normal'
+                                ELSE 'optimal'
+    END;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS medication_intakes;
+DROP TABLE IF EXISTS medications;
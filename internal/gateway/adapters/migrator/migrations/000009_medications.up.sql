@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS medications
+(
+    id            SERIAL PRIMARY KEY,
+    user_id       INTEGER                 NOT NULL,
+    name          VARCHAR(255)            NOT NULL,
+    drug_class    VARCHAR(255)            NOT NULL,
+    dose          VARCHAR(255) NOT NULL DEFAULT '',
+    doses_per_day SMALLINT                NOT NULL,
+    start_date    DATE                    NOT NULL,
+    stop_date     DATE,
+    created_at    TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS medications_user_id_idx ON medications (user_id);
+
+CREATE TABLE IF NOT EXISTS medication_intakes
+(
+    id            SERIAL PRIMARY KEY,
+    user_id       INTEGER                 NOT NULL,
+    medication_id INTEGER                 NOT NULL REFERENCES medications (id) ON DELETE CASCADE,
+    taken_at      TIMESTAMP               NOT NULL,
+    created_at    TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS medication_intakes_user_id_taken_at_idx ON medication_intakes (user_id, taken_at);
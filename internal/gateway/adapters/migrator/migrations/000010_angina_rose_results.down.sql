@@ -0,0 +1 @@
+DROP TABLE IF EXISTS angina_rose_results;
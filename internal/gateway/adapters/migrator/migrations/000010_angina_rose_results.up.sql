@@ -0,0 +1,13 @@
+-- the Rose angina questionnaire submissions, the answers column contains the JSON representation of the answers
+CREATE TABLE IF NOT EXISTS angina_rose_results
+(
+    id                  SERIAL PRIMARY KEY,
+    user_id             INTEGER                 NOT NULL,
+    answers             JSONB                   NOT NULL,
+    classification      VARCHAR(255)            NOT NULL,
+    grade               SMALLINT                NOT NULL DEFAULT 0,
+    possible_infarction BOOLEAN                 NOT NULL DEFAULT FALSE,
+    created_at          TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS angina_rose_results_user_id_idx ON angina_rose_results (user_id);
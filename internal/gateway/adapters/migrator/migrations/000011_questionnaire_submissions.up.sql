@@ -0,0 +1,14 @@
+-- the submissions of the questionnaires defined in the config, the answers and results columns contain the JSON
+-- representation of the answers and the calculated scales
+CREATE TABLE IF NOT EXISTS questionnaire_submissions
+(
+    id                    SERIAL PRIMARY KEY,
+    user_id               INTEGER                 NOT NULL,
+    questionnaire_code    VARCHAR(255)            NOT NULL,
+    questionnaire_version INTEGER                 NOT NULL,
+    answers               JSONB                   NOT NULL,
+    results               JSONB                   NOT NULL,
+    created_at            TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS questionnaire_submissions_user_id_code_idx ON questionnaire_submissions (user_id, questionnaire_code);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS questionnaire_revisions;
+DROP TABLE IF EXISTS lifestyles_revisions;
+DROP TABLE IF EXISTS diseases_revisions;
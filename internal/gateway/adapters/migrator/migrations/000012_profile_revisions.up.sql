@@ -0,0 +1,77 @@
+-- the states of the user diseases, lifestyle and questionnaire saved on every update, the record column contains the
+-- JSON representation of the record, the latest revision is equal to the current record
+CREATE TABLE IF NOT EXISTS diseases_revisions
+(
+    id         SERIAL PRIMARY KEY,
+    user_id    INTEGER                 NOT NULL,
+    record     JSONB                   NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS diseases_revisions_user_id_idx ON diseases_revisions (user_id);
+
+CREATE TABLE IF NOT EXISTS lifestyles_revisions
+(
+    id         SERIAL PRIMARY KEY,
+    user_id    INTEGER                 NOT NULL,
+    record     JSONB                   NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS lifestyles_revisions_user_id_idx ON lifestyles_revisions (user_id);
+
+CREATE TABLE IF NOT EXISTS questionnaire_revisions
+(
+    id         SERIAL PRIMARY KEY,
+    user_id    INTEGER                 NOT NULL,
+    record     JSONB                   NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS questionnaire_revisions_user_id_idx ON questionnaire_revisions (user_id);
+
+-- the records filled before are saved as the first revisions, the records with the default values only are skipped
+INSERT INTO diseases_revisions (user_id, record)
+SELECT user_id,
+       json_build_object(
+               'cvdPredisposed', cvd_predisposed,
+               'takesStatins', takes_statins,
+               'hasChronicKidneyDisease', has_chronic_kidney_disease,
+               'hasArterialHypertension', has_arterial_hypertension,
+               'hasIschemicHeartDisease', has_ischemic_heart_disease,
+               'hasTypeTwoDiabetes', has_type_two_diabetes,
+               'hadInfarctionOrStroke', had_infarction_or_stroke,
+               'hasAtherosclerosis', has_atherosclerosis,
+               'hasOtherCVD', has_other_cvd
+           )
+FROM diseases
+WHERE cvd_predisposed OR takes_statins OR has_chronic_kidney_disease OR has_arterial_hypertension OR
+      has_ischemic_heart_disease OR has_type_two_diabetes OR had_infarction_or_stroke OR has_atherosclerosis OR
+      has_other_cvd;
+
+INSERT INTO lifestyles_revisions (user_id, record)
+SELECT user_id,
+       json_build_object(
+               'familyStatus', family_status,
+               'eventsParticipation', events_participation,
+               'physicalActivity', physical_activity,
+               'workStatus', work_status,
+               'significantValueHigh', significant_value_high,
+               'significantValueMedium', significant_value_medium,
+               'significantValueLow', significant_value_low
+           )
+FROM lifestyles
+WHERE family_status <> '' OR events_participation <> '' OR physical_activity <> '' OR work_status <> '' OR
+      significant_value_high <> '' OR significant_value_medium <> '' OR significant_value_low <> '';
+
+INSERT INTO questionnaire_revisions (user_id, record)
+SELECT user_id,
+       json_build_object(
+               'anginaScore', angina_score,
+               'adherenceDrugTherapy', adherence_drug_therapy,
+               'adherenceMedicalSupport', adherence_medical_support,
+               'adherenceLifestyleMod', adherence_lifestyle_mod
+           )
+FROM questionnaire
+WHERE angina_score <> -1 OR adherence_drug_therapy <> -1 OR adherence_medical_support <> -1 OR
+      adherence_lifestyle_mod <> -1;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS metabolic_syndrome_screenings;
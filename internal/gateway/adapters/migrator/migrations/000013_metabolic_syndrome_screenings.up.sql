@@ -0,0 +1,13 @@
+-- the metabolic syndrome screening results, one per user and evaluation date, the definitions and missing_data
+-- columns contain the JSON representations of the results by the definitions and the data still needed
+CREATE TABLE IF NOT EXISTS metabolic_syndrome_screenings
+(
+    id              SERIAL PRIMARY KEY,
+    user_id         INTEGER                 NOT NULL,
+    evaluation_date DATE                    NOT NULL,
+    status          VARCHAR(255)            NOT NULL,
+    definitions     JSONB                   NOT NULL,
+    missing_data    JSONB                   NOT NULL,
+    updated_at      TIMESTAMP DEFAULT NOW() NOT NULL,
+    UNIQUE (user_id, evaluation_date)
+);
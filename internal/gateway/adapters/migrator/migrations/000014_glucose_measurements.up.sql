@@ -0,0 +1,12 @@
+-- the glycaemic diary: the glucose levels (mmol/L) and the HbA1c values (%)
+CREATE TABLE IF NOT EXISTS glucose_measurements
+(
+    id          SERIAL PRIMARY KEY,
+    user_id     INTEGER                 NOT NULL,
+    type        VARCHAR(255)            NOT NULL,
+    value       DECIMAL(10, 3)          NOT NULL,
+    measured_at TIMESTAMP               NOT NULL,
+    created_at  TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS glucose_measurements_user_id_measured_at_idx ON glucose_measurements (user_id, measured_at);
@@ -0,0 +1,14 @@
+-- the physical activity log, the MET value is taken from the built-in compendium on the entry creation
+CREATE TABLE IF NOT EXISTS activity_entries
+(
+    id           SERIAL PRIMARY KEY,
+    user_id      INTEGER                 NOT NULL,
+    type         VARCHAR(255)            NOT NULL,
+    intensity    VARCHAR(255)            NOT NULL,
+    duration     SMALLINT                NOT NULL,
+    met          DECIMAL(4, 1)           NOT NULL,
+    performed_at TIMESTAMP               NOT NULL,
+    created_at   TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS activity_entries_user_id_performed_at_idx ON activity_entries (user_id, performed_at);
@@ -0,0 +1,16 @@
+CREATE OR REPLACE VIEW analyses_view AS
+SELECT a.id,
+       a.user_id,
+       MAX(r.value) FILTER (WHERE r.test_code = 'highDensityCholesterol')          AS high_density_cholesterol,
+       MAX(r.value) FILTER (WHERE r.test_code = 'lowDensityCholesterol')           AS low_density_cholesterol,
+       MAX(r.value) FILTER (WHERE r.test_code = 'triglycerides')                   AS triglycerides,
+       MAX(r.value) FILTER (WHERE r.test_code = 'lipoprotein')                     AS lipoprotein,
+       MAX(r.value) FILTER (WHERE r.test_code = 'highlySensitiveCReactiveProtein') AS highly_sensitive_c_reactive_protein,
+       MAX(r.value) FILTER (WHERE r.test_code = 'atherogenicityCoefficient')       AS atherogenicity_coefficient,
+       MAX(r.value) FILTER (WHERE r.test_code = 'creatinine')                      AS creatinine,
+       a.atherosclerotic_plaques_presence,
+       a.created_at,
+       a.deleted_at
+FROM analyses a
+         LEFT JOIN lab_results r ON r.analysis_id = a.id
+GROUP BY a.id;
@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+const activityTable = "activity_entries"
+
+// check whether ActivityRepository structure implements the storage.ActivityRepository interface
+var _ storage.ActivityRepository = (*ActivityRepository)(nil)
+
+// ActivityRepository implements storage.ActivityRepository interface.
+type ActivityRepository struct {
+	storage *Storage
+}
+
+func NewActivityRepository(storage *Storage) *ActivityRepository {
+	return &ActivityRepository{
+		storage: storage,
+	}
+}
+
+func (r *ActivityRepository) Create(entry model.ActivityEntry) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %v (user_id, type, intensity, duration, met, performed_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		activityTable,
+	)
+	queryCtx := context.Background()
+
+	_, err := r.storage.conn.Exec(queryCtx, query,
+		entry.UserID,
+		entry.Type,
+		entry.Intensity,
+		entry.Duration,
+		entry.MET,
+		entry.PerformedAt.Time,
+	)
+	return err
+}
+
+func (r *ActivityRepository) Delete(id, userID uint64) error {
+	query := fmt.Sprintf(`DELETE FROM %v WHERE id=$1 AND user_id=$2`, activityTable)
+	queryCtx := context.Background()
+
+	result, err := r.storage.conn.Exec(queryCtx, query, id, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *ActivityRepository) FindAll(criteria model.ActivityLogCriteria) ([]*model.ActivityEntry, error) {
+	query := fmt.Sprintf(`
+		SELECT id,
+		       user_id,
+		       type,
+		       intensity,
+		       duration,
+		       met,
+		       performed_at,
+		       created_at
+		FROM %v
+		WHERE user_id=$1 AND
+		      ($2::TIMESTAMP IS NULL OR performed_at >= $2) AND
+		      ($3::TIMESTAMP IS NULL OR performed_at < $3)
+		ORDER BY performed_at DESC, id DESC`,
+		activityTable,
+	)
+	queryCtx := context.Background()
+
+	var from, to *time.Time
+	if !criteria.From.IsZero() {
+		from = &criteria.From
+	}
+	if !criteria.To.IsZero() {
+		// the upper bound is inclusive, so the entries of the whole day are included
+		nextDay := criteria.To.AddDate(0, 0, 1)
+		to = &nextDay
+	}
+
+	rows, err := r.storage.conn.Query(queryCtx, query, criteria.UserID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanActivityEntries(rows)
+}
+
+func (r *ActivityRepository) All(since time.Time) ([]*model.ActivityEntry, error) {
+	query := fmt.Sprintf(`
+		SELECT id,
+		       user_id,
+		       type,
+		       intensity,
+		       duration,
+		       met,
+		       performed_at,
+		       created_at
+		FROM %v
+		WHERE performed_at >= $1
+		ORDER BY user_id, performed_at DESC, id DESC`,
+		activityTable,
+	)
+	queryCtx := context.Background()
+
+	rows, err := r.storage.conn.Query(queryCtx, query, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanActivityEntries(rows)
+}
+
+func scanActivityEntries(rows pgx.Rows) ([]*model.ActivityEntry, error) {
+	entries := make([]*model.ActivityEntry, 0, 16)
+	for rows.Next() {
+		var entry model.ActivityEntry
+
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.UserID,
+			&entry.Type,
+			&entry.Intensity,
+			&entry.Duration,
+			&entry.MET,
+			&entry.PerformedAt.Time,
+			&entry.CreatedAt.Time,
+		); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -12,7 +13,12 @@ import (
 	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
 )
 
-const analysisTable = "analyses"
+const (
+	analysisTable = "analyses"
+	// analysisViewTable is the compatibility view of the lab results with the former analyses columns
+	analysisViewTable    = "analyses_view"
+	analysisHistoryTable = "analyses_history"
+)
 
 // check whether AnalysisRepository structure implements the storage.AnalysisRepository interface
 var _ storage.AnalysisRepository = (*AnalysisRepository)(nil)
@@ -31,59 +37,121 @@ func NewAnalysisRepository(storage *Storage) *AnalysisRepository {
 func (r *AnalysisRepository) Save(analysisData model.Analysis) error {
 	queryCtx := context.Background()
 
+	tx, err := r.storage.conn.Begin(queryCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(queryCtx)
+	}()
+
 	analysisIDPlaceholder := "DEFAULT"
 	if analysisData.ID != 0 {
 		analysisIDPlaceholder = "$1"
-	}
 
-	updateSetStmtArgs := `
-        high_density_cholesterol=$3,
-		low_density_cholesterol=$4,
-		triglycerides=$5,
-		lipoprotein=$6,
-		highly_sensitive_c_reactive_protein=$7,
-        atherogenicity_coefficient=$8,
-        creatinine=$9,
-        atherosclerotic_plaques_presence=$10`
+		if err = r.saveHistory(queryCtx, tx, analysisData.ID, analysisData.UserID); err != nil {
+			return err
+		}
+	}
 
 	query := fmt.Sprintf(`
 		INSERT INTO %[1]v (id,
 		                user_id,
-						high_density_cholesterol,
-						low_density_cholesterol,
-						triglycerides,
-						lipoprotein,
-						highly_sensitive_c_reactive_protein,
-						atherogenicity_coefficient,
-						creatinine,
 		                atherosclerotic_plaques_presence)
-		VALUES (%[2]v, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		VALUES (%[2]v, $2, $3)
 		ON CONFLICT (id) 
 		    DO UPDATE SET 
-		        %[3]v 
-		    WHERE %[1]v.id=$1 AND %[1]v.user_id=$2`,
-		analysisTable, analysisIDPlaceholder, updateSetStmtArgs,
+		        atherosclerotic_plaques_presence=$3 
+		    WHERE %[1]v.id=$1 AND %[1]v.user_id=$2
+		RETURNING id, created_at`,
+		analysisTable, analysisIDPlaceholder,
 	)
 
-	_, err := r.storage.conn.Exec(queryCtx, query,
+	if err = tx.QueryRow(queryCtx, query,
 		analysisData.ID,
 		analysisData.UserID,
-		analysisData.HighDensityCholesterol,
-		analysisData.LowDensityCholesterol,
-		analysisData.Triglycerides,
-		analysisData.Lipoprotein,
-		analysisData.HighlySensitiveCReactiveProtein,
-		analysisData.AtherogenicityCoefficient,
-		analysisData.Creatinine,
 		analysisData.AtheroscleroticPlaquesPresence,
+	).Scan(
+		&analysisData.ID,
+		&analysisData.CreatedAt.Time,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return sql.ErrNoRows
+		}
+		return err
+	}
+
+	// the values are stored in the catalog (SI) units of the lab tests
+	upsertQuery := fmt.Sprintf(`
+		INSERT INTO %[1]v (user_id, analysis_id, test_code, unit, value, collected_at)
+		VALUES ($1, $2, $3, (SELECT unit FROM %[2]v WHERE code=$3), $4, $5)
+		ON CONFLICT (analysis_id, test_code)
+		    DO UPDATE SET
+		        value=$4`,
+		labResultTable, labTestTable,
 	)
-	if err != nil && errors.Is(err, pgx.ErrNoRows) {
-		return sql.ErrNoRows
+	deleteQuery := fmt.Sprintf(
+		`DELETE FROM %v WHERE analysis_id=$1 AND test_code=$2`,
+		labResultTable,
+	)
+
+	for testCode, value := range analysisData.LabTestValues() {
+		if *value == nil {
+			_, err = tx.Exec(queryCtx, deleteQuery, analysisData.ID, testCode)
+		} else {
+			_, err = tx.Exec(queryCtx, upsertQuery,
+				analysisData.UserID,
+				analysisData.ID,
+				testCode,
+				**value,
+				analysisData.CreatedAt.Time,
+			)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(queryCtx)
+}
+
+// saveHistory saves the current data of the user analysis to the analysis history.
+func (r *AnalysisRepository) saveHistory(ctx context.Context, tx pgx.Tx, id, userID uint64) error {
+	analysisData, err := r.get(ctx, tx, id, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return sql.ErrNoRows
+		}
+		return err
+	}
+
+	record, err := json.Marshal(analysisData)
+	if err != nil {
+		return err
 	}
+
+	query := fmt.Sprintf(
+		`INSERT INTO %v (analysis_id, user_id, record) VALUES ($1, $2, $3)`,
+		analysisHistoryTable,
+	)
+
+	_, err = tx.Exec(ctx, query, id, userID, record)
 	return err
 }
 
 func (r *AnalysisRepository) Get(id, userID uint64) (*model.Analysis, error) {
+	analysisData, err := r.get(context.Background(), r.storage.conn, id, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return analysisData, nil
+}
+
+func (r *AnalysisRepository) get(ctx context.Context, q querier, id, userID uint64) (*model.Analysis, error) {
 	query := fmt.Sprintf(`
 		SELECT 
 			id,
@@ -98,14 +166,13 @@ func (r *AnalysisRepository) Get(id, userID uint64) (*model.Analysis, error) {
 			atherosclerotic_plaques_presence,
 			created_at
 		FROM %v
-		WHERE id=$1 AND user_id=$2`,
-		analysisTable,
+		WHERE id=$1 AND user_id=$2 AND deleted_at IS NULL`,
+		analysisViewTable,
 	)
-	queryCtx := context.Background()
 
 	var analysisData model.Analysis
-	if err := r.storage.conn.QueryRow(
-		queryCtx, query, id, userID,
+	if err := q.QueryRow(
+		ctx, query, id, userID,
 	).Scan(
 		&analysisData.ID,
 		&analysisData.UserID,
@@ -119,9 +186,6 @@ func (r *AnalysisRepository) Get(id, userID uint64) (*model.Analysis, error) {
 		&analysisData.AtheroscleroticPlaquesPresence,
 		&analysisData.CreatedAt.Time,
 	); err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, sql.ErrNoRows
-		}
 		return nil, err
 	}
 
@@ -129,6 +193,13 @@ func (r *AnalysisRepository) Get(id, userID uint64) (*model.Analysis, error) {
 }
 
 func (r *AnalysisRepository) FindAll(userID uint64) ([]*model.Analysis, error) {
+	analyses, _, err := r.Find(model.RecordsCriteria{
+		UserID: userID,
+	})
+	return analyses, err
+}
+
+func (r *AnalysisRepository) Find(criteria model.RecordsCriteria) ([]*model.Analysis, uint64, error) {
 	queryCtx := context.Background()
 
 	query := fmt.Sprintf(`
@@ -145,25 +216,65 @@ func (r *AnalysisRepository) FindAll(userID uint64) ([]*model.Analysis, error) {
 			atherosclerotic_plaques_presence,
 			created_at
 		FROM %v
-		WHERE user_id=$1
-		ORDER BY id DESC`,
-		analysisTable,
+		%v`,
+		analysisViewTable, recordsCriteriaCondition,
 	)
 
-	rows, err := r.storage.conn.Query(queryCtx, query, userID)
+	rows, err := r.storage.conn.Query(queryCtx, query, recordsCriteriaArgs(criteria)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	analyses, err := scanAnalyses(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, nextCursor := recordsNextCursor(criteria, len(analyses), func(i int) uint64 {
+		return analyses[i].ID
+	})
+
+	return analyses[:count], nextCursor, nil
+}
+
+func (r *AnalysisRepository) All() ([]*model.Analysis, error) {
+	queryCtx := context.Background()
+
+	query := fmt.Sprintf(`
+		SELECT 
+			id,
+			user_id,
+			high_density_cholesterol,
+			low_density_cholesterol,
+			triglycerides,
+			lipoprotein,
+			highly_sensitive_c_reactive_protein,
+			atherogenicity_coefficient,
+			creatinine,
+			atherosclerotic_plaques_presence,
+			created_at
+		FROM %v
+		WHERE deleted_at IS NULL
+		ORDER BY user_id, id DESC`,
+		analysisViewTable,
+	)
+
+	rows, err := r.storage.conn.Query(queryCtx, query)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
-		}
 		return nil, err
 	}
 	defer rows.Close()
 
+	return scanAnalyses(rows)
+}
+
+func scanAnalyses(rows pgx.Rows) ([]*model.Analysis, error) {
 	analyses := make([]*model.Analysis, 0, 3)
 	for rows.Next() {
 		var analysis model.Analysis
 
-		if err = rows.Scan(
+		if err := rows.Scan(
 			&analysis.ID,
 			&analysis.UserID,
 			&analysis.HighDensityCholesterol,
@@ -182,9 +293,70 @@ func (r *AnalysisRepository) FindAll(userID uint64) ([]*model.Analysis, error) {
 		analyses = append(analyses, &analysis)
 	}
 
-	if rows.Err() != nil {
+	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 
 	return analyses, nil
 }
+
+func (r *AnalysisRepository) Delete(id, userID uint64) error {
+	query := fmt.Sprintf(`
+		UPDATE %v
+		SET deleted_at=NOW()
+		WHERE id=$1 AND user_id=$2 AND deleted_at IS NULL`,
+		analysisTable,
+	)
+	queryCtx := context.Background()
+
+	result, err := r.storage.conn.Exec(queryCtx, query, id, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *AnalysisRepository) History(id, userID uint64) ([]*model.AnalysisRevision, error) {
+	query := fmt.Sprintf(`
+		SELECT record,
+		       changed_at
+		FROM %v
+		WHERE analysis_id=$1 AND user_id=$2
+		ORDER BY id DESC`,
+		analysisHistoryTable,
+	)
+	queryCtx := context.Background()
+
+	rows, err := r.storage.conn.Query(queryCtx, query, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revisions := make([]*model.AnalysisRevision, 0, 3)
+	for rows.Next() {
+		var (
+			record   []byte
+			revision model.AnalysisRevision
+		)
+
+		if err = rows.Scan(&record, &revision.ChangedAt.Time); err != nil {
+			return nil, err
+		}
+
+		if err = json.Unmarshal(record, &revision.Analysis); err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, &revision)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
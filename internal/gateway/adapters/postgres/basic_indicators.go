@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -12,7 +13,10 @@ import (
 	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
 )
 
-const basicIndicatorsTable = "basic_indicators"
+const (
+	basicIndicatorsTable        = "basic_indicators"
+	basicIndicatorsHistoryTable = "basic_indicators_history"
+)
 
 // check whether BasicIndicatorsRepository structure implements the storage.BasicIndicatorsRepository interface
 var _ storage.BasicIndicatorsRepository = (*BasicIndicatorsRepository)(nil)
@@ -31,9 +35,21 @@ func NewBasicIndicatorsRepository(storage *Storage) *BasicIndicatorsRepository {
 func (r *BasicIndicatorsRepository) Save(basicIndicatorsData model.BasicIndicators) error {
 	queryCtx := context.Background()
 
+	tx, err := r.storage.conn.Begin(queryCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(queryCtx)
+	}()
+
 	basicIndicatorsIDPlaceholder := "DEFAULT"
 	if basicIndicatorsData.ID != 0 {
 		basicIndicatorsIDPlaceholder = "$1"
+
+		if err = r.saveHistory(queryCtx, tx, basicIndicatorsData.ID, basicIndicatorsData.UserID); err != nil {
+			return err
+		}
 	}
 
 	updateSetStmtArgs := `
@@ -46,7 +62,9 @@ func (r *BasicIndicatorsRepository) Save(basicIndicatorsData model.BasicIndicato
         smoking=$9,
         total_cholesterol_level=$10,
         cv_events_risk_value=$11,
-        ideal_cardiovascular_ages_range=$12`
+        ideal_cardiovascular_ages_range=$12,
+        risk_scope=$13,
+        hypertension_category=$14`
 
 	query := fmt.Sprintf(`
 		INSERT INTO %[1]v (id,
@@ -60,8 +78,10 @@ func (r *BasicIndicatorsRepository) Save(basicIndicatorsData model.BasicIndicato
 						smoking,
 						total_cholesterol_level,
 						cv_events_risk_value,
-						ideal_cardiovascular_ages_range)
-		VALUES (%[2]v, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+						ideal_cardiovascular_ages_range,
+						risk_scope,
+						hypertension_category)
+		VALUES (%[2]v, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 		ON CONFLICT (id) 
 		    DO UPDATE SET 
 		        %[3]v 
@@ -69,7 +89,7 @@ func (r *BasicIndicatorsRepository) Save(basicIndicatorsData model.BasicIndicato
 		basicIndicatorsTable, basicIndicatorsIDPlaceholder, updateSetStmtArgs,
 	)
 
-	_, err := r.storage.conn.Exec(queryCtx, query,
+	_, err = tx.Exec(queryCtx, query,
 		basicIndicatorsData.ID,
 		basicIndicatorsData.UserID,
 		basicIndicatorsData.Weight,
@@ -82,14 +102,56 @@ func (r *BasicIndicatorsRepository) Save(basicIndicatorsData model.BasicIndicato
 		basicIndicatorsData.TotalCholesterolLevel,
 		basicIndicatorsData.CVEventsRiskValue,
 		basicIndicatorsData.IdealCardiovascularAgesRange,
+		basicIndicatorsData.RiskScope,
+		basicIndicatorsData.HypertensionCategory,
 	)
-	if err != nil && errors.Is(err, pgx.ErrNoRows) {
-		return sql.ErrNoRows
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return sql.ErrNoRows
+		}
+		return err
 	}
+
+	return tx.Commit(queryCtx)
+}
+
+// saveHistory saves the current data of the user basic indicators to the basic indicators history.
+func (r *BasicIndicatorsRepository) saveHistory(ctx context.Context, tx pgx.Tx, id, userID uint64) error {
+	basicIndicatorsData, err := r.get(ctx, tx, id, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return sql.ErrNoRows
+		}
+		return err
+	}
+
+	record, err := json.Marshal(basicIndicatorsData)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf(
+		`INSERT INTO %v (basic_indicators_id, user_id, record) VALUES ($1, $2, $3)`,
+		basicIndicatorsHistoryTable,
+	)
+
+	_, err = tx.Exec(ctx, query, id, userID, record)
 	return err
 }
 
 func (r *BasicIndicatorsRepository) Get(id, userID uint64) (*model.BasicIndicators, error) {
+	basicIndicatorsData, err := r.get(context.Background(), r.storage.conn, id, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return basicIndicatorsData, nil
+}
+
+func (r *BasicIndicatorsRepository) get(ctx context.Context, q querier, id, userID uint64) (*model.BasicIndicators, error) {
 	query := fmt.Sprintf(`
 		SELECT 
 			id,
@@ -104,16 +166,17 @@ func (r *BasicIndicatorsRepository) Get(id, userID uint64) (*model.BasicIndicato
 			total_cholesterol_level,
 			cv_events_risk_value,
 			ideal_cardiovascular_ages_range,
+			risk_scope,
+			hypertension_category,
 			created_at
 		FROM %v
-		WHERE id=$1 AND user_id=$2`,
+		WHERE id=$1 AND user_id=$2 AND deleted_at IS NULL`,
 		basicIndicatorsTable,
 	)
-	queryCtx := context.Background()
 
 	var basicIndicatorsData model.BasicIndicators
-	if err := r.storage.conn.QueryRow(
-		queryCtx, query, id, userID,
+	if err := q.QueryRow(
+		ctx, query, id, userID,
 	).Scan(
 		&basicIndicatorsData.ID,
 		&basicIndicatorsData.UserID,
@@ -127,11 +190,10 @@ func (r *BasicIndicatorsRepository) Get(id, userID uint64) (*model.BasicIndicato
 		&basicIndicatorsData.TotalCholesterolLevel,
 		&basicIndicatorsData.CVEventsRiskValue,
 		&basicIndicatorsData.IdealCardiovascularAgesRange,
+		&basicIndicatorsData.RiskScope,
+		&basicIndicatorsData.HypertensionCategory,
 		&basicIndicatorsData.CreatedAt.Time,
 	); err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, sql.ErrNoRows
-		}
 		return nil, err
 	}
 
@@ -139,6 +201,13 @@ func (r *BasicIndicatorsRepository) Get(id, userID uint64) (*model.BasicIndicato
 }
 
 func (r *BasicIndicatorsRepository) FindAll(userID uint64) ([]*model.BasicIndicators, error) {
+	basicIndicators, _, err := r.Find(model.RecordsCriteria{
+		UserID: userID,
+	})
+	return basicIndicators, err
+}
+
+func (r *BasicIndicatorsRepository) Find(criteria model.RecordsCriteria) ([]*model.BasicIndicators, uint64, error) {
 	queryCtx := context.Background()
 
 	query := fmt.Sprintf(`
@@ -155,23 +224,21 @@ func (r *BasicIndicatorsRepository) FindAll(userID uint64) ([]*model.BasicIndica
 			total_cholesterol_level,
 			cv_events_risk_value,
 			ideal_cardiovascular_ages_range,
+			risk_scope,
+			hypertension_category,
 			created_at
 		FROM %v
-		WHERE user_id=$1
-		ORDER BY id DESC`,
-		basicIndicatorsTable,
+		%v`,
+		basicIndicatorsTable, recordsCriteriaCondition,
 	)
 
-	rows, err := r.storage.conn.Query(queryCtx, query, userID)
+	rows, err := r.storage.conn.Query(queryCtx, query, recordsCriteriaArgs(criteria)...)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
-		}
-		return nil, err
+		return nil, 0, err
 	}
 	defer rows.Close()
 
-	analyses := make([]*model.BasicIndicators, 0, 3)
+	basicIndicatorsList := make([]*model.BasicIndicators, 0, 3)
 	for rows.Next() {
 		var basicIndicators model.BasicIndicators
 
@@ -188,19 +255,86 @@ func (r *BasicIndicatorsRepository) FindAll(userID uint64) ([]*model.BasicIndica
 			&basicIndicators.TotalCholesterolLevel,
 			&basicIndicators.CVEventsRiskValue,
 			&basicIndicators.IdealCardiovascularAgesRange,
+			&basicIndicators.RiskScope,
+			&basicIndicators.HypertensionCategory,
 			&basicIndicators.CreatedAt.Time,
 		); err != nil {
+			return nil, 0, err
+		}
+
+		basicIndicatorsList = append(basicIndicatorsList, &basicIndicators)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	count, nextCursor := recordsNextCursor(criteria, len(basicIndicatorsList), func(i int) uint64 {
+		return basicIndicatorsList[i].ID
+	})
+
+	return basicIndicatorsList[:count], nextCursor, nil
+}
+
+func (r *BasicIndicatorsRepository) Delete(id, userID uint64) error {
+	query := fmt.Sprintf(`
+		UPDATE %v
+		SET deleted_at=NOW()
+		WHERE id=$1 AND user_id=$2 AND deleted_at IS NULL`,
+		basicIndicatorsTable,
+	)
+	queryCtx := context.Background()
+
+	result, err := r.storage.conn.Exec(queryCtx, query, id, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *BasicIndicatorsRepository) History(id, userID uint64) ([]*model.BasicIndicatorsRevision, error) {
+	query := fmt.Sprintf(`
+		SELECT record,
+		       changed_at
+		FROM %v
+		WHERE basic_indicators_id=$1 AND user_id=$2
+		ORDER BY id DESC`,
+		basicIndicatorsHistoryTable,
+	)
+	queryCtx := context.Background()
+
+	rows, err := r.storage.conn.Query(queryCtx, query, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revisions := make([]*model.BasicIndicatorsRevision, 0, 3)
+	for rows.Next() {
+		var (
+			record   []byte
+			revision model.BasicIndicatorsRevision
+		)
+
+		if err = rows.Scan(&record, &revision.ChangedAt.Time); err != nil {
+			return nil, err
+		}
+
+		if err = json.Unmarshal(record, &revision.BasicIndicators); err != nil {
 			return nil, err
 		}
 
-		analyses = append(analyses, &basicIndicators)
+		revisions = append(revisions, &revision)
 	}
 
-	if rows.Err() != nil {
+	if err = rows.Err(); err != nil {
 		return nil, err
 	}
 
-	return analyses, nil
+	return revisions, nil
 }
 
 func (r *BasicIndicatorsRepository) All() ([]model.BasicIndicators, error) {
@@ -220,8 +354,12 @@ func (r *BasicIndicatorsRepository) All() ([]model.BasicIndicators, error) {
 			total_cholesterol_level,
 			cv_events_risk_value,
 			ideal_cardiovascular_ages_range,
+			risk_scope,
+			hypertension_category,
 			created_at
-		FROM %v`,
+		FROM %v
+		WHERE deleted_at IS NULL
+		ORDER BY user_id, id DESC`,
 		basicIndicatorsTable,
 	)
 
@@ -251,6 +389,8 @@ func (r *BasicIndicatorsRepository) All() ([]model.BasicIndicators, error) {
 			&basicIndicators.TotalCholesterolLevel,
 			&basicIndicators.CVEventsRiskValue,
 			&basicIndicators.IdealCardiovascularAgesRange,
+			&basicIndicators.RiskScope,
+			&basicIndicators.HypertensionCategory,
 			&basicIndicators.CreatedAt.Time,
 		); err != nil {
 			return nil, err
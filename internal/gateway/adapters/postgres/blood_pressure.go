@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+const bloodPressureTable = "blood_pressure_measurements"
+
+// check whether BloodPressureRepository structure implements the storage.BloodPressureRepository interface
+var _ storage.BloodPressureRepository = (*BloodPressureRepository)(nil)
+
+// BloodPressureRepository implements storage.BloodPressureRepository interface.
+type BloodPressureRepository struct {
+	storage *Storage
+}
+
+func NewBloodPressureRepository(storage *Storage) *BloodPressureRepository {
+	return &BloodPressureRepository{
+		storage: storage,
+	}
+}
+
+func (r *BloodPressureRepository) Create(measurement model.BloodPressureMeasurement) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %v (user_id, systolic, diastolic, pulse, arm, position, time_of_day, hypertension_category, measured_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		bloodPressureTable,
+	)
+	queryCtx := context.Background()
+
+	_, err := r.storage.conn.Exec(queryCtx, query,
+		measurement.UserID,
+		measurement.Systolic,
+		measurement.Diastolic,
+		measurement.Pulse,
+		measurement.Arm,
+		measurement.Position,
+		measurement.TimeOfDay,
+		measurement.HypertensionCategory,
+		measurement.MeasuredAt.Time,
+	)
+	return err
+}
+
+func (r *BloodPressureRepository) Delete(id, userID uint64) error {
+	query := fmt.Sprintf(`DELETE FROM %v WHERE id=$1 AND user_id=$2`, bloodPressureTable)
+	queryCtx := context.Background()
+
+	result, err := r.storage.conn.Exec(queryCtx, query, id, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *BloodPressureRepository) FindAll(criteria model.BloodPressureDiaryCriteria) ([]*model.BloodPressureMeasurement, error) {
+	query := fmt.Sprintf(`
+		SELECT id,
+		       user_id,
+		       systolic,
+		       diastolic,
+		       pulse,
+		       arm,
+		       position,
+		       time_of_day,
+		       hypertension_category,
+		       measured_at,
+		       created_at
+		FROM %v
+		WHERE user_id=$1 AND
+		      ($2::TIMESTAMP IS NULL OR measured_at >= $2) AND
+		      ($3::TIMESTAMP IS NULL OR measured_at < $3)
+		ORDER BY measured_at DESC, id DESC`,
+		bloodPressureTable,
+	)
+	queryCtx := context.Background()
+
+	var from, to *time.Time
+	if !criteria.From.IsZero() {
+		from = &criteria.From
+	}
+	if !criteria.To.IsZero() {
+		// the upper bound is inclusive, so the measurements of the whole day are included
+		nextDay := criteria.To.AddDate(0, 0, 1)
+		to = &nextDay
+	}
+
+	rows, err := r.storage.conn.Query(queryCtx, query, criteria.UserID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanBloodPressureMeasurements(rows)
+}
+
+func (r *BloodPressureRepository) All() ([]*model.BloodPressureMeasurement, error) {
+	query := fmt.Sprintf(`
+		SELECT id,
+		       user_id,
+		       systolic,
+		       diastolic,
+		       pulse,
+		       arm,
+		       position,
+		       time_of_day,
+		       hypertension_category,
+		       measured_at,
+		       created_at
+		FROM %v
+		ORDER BY user_id, measured_at DESC, id DESC`,
+		bloodPressureTable,
+	)
+	queryCtx := context.Background()
+
+	rows, err := r.storage.conn.Query(queryCtx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanBloodPressureMeasurements(rows)
+}
+
+func scanBloodPressureMeasurements(rows pgx.Rows) ([]*model.BloodPressureMeasurement, error) {
+	measurements := make([]*model.BloodPressureMeasurement, 0, 16)
+	for rows.Next() {
+		var measurement model.BloodPressureMeasurement
+
+		if err := rows.Scan(
+			&measurement.ID,
+			&measurement.UserID,
+			&measurement.Systolic,
+			&measurement.Diastolic,
+			&measurement.Pulse,
+			&measurement.Arm,
+			&measurement.Position,
+			&measurement.TimeOfDay,
+			&measurement.HypertensionCategory,
+			&measurement.MeasuredAt.Time,
+			&measurement.CreatedAt.Time,
+		); err != nil {
+			return nil, err
+		}
+
+		measurements = append(measurements, &measurement)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return measurements, nil
+}
@@ -28,6 +28,16 @@ func NewDiseasesRepository(storage *Storage) *DiseasesRepository {
 }
 
 func (r *DiseasesRepository) Update(diseasesData model.Diseases) error {
+	queryCtx := context.Background()
+
+	tx, err := r.storage.conn.Begin(queryCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(queryCtx)
+	}()
+
 	query := fmt.Sprintf(`
 		UPDATE %v
         SET 
@@ -40,12 +50,22 @@ func (r *DiseasesRepository) Update(diseasesData model.Diseases) error {
             had_infarction_or_stroke=$8,
             has_atherosclerosis=$9,
             has_other_cvd=$10
-        WHERE user_id=$1`,
+        WHERE user_id=$1
+        RETURNING user_id,
+                  cvd_predisposed,
+                  takes_statins,
+                  has_chronic_kidney_disease,
+                  has_arterial_hypertension,
+                  has_ischemic_heart_disease,
+                  has_type_two_diabetes,
+                  had_infarction_or_stroke,
+                  has_atherosclerosis,
+                  has_other_cvd`,
 		diseasesTable,
 	)
-	queryCtx := context.Background()
 
-	_, err := r.storage.conn.Exec(queryCtx, query,
+	var updated model.Diseases
+	if err = tx.QueryRow(queryCtx, query,
 		diseasesData.UserID,
 		diseasesData.CVDPredisposed,
 		diseasesData.TakesStatins,
@@ -56,8 +76,30 @@ func (r *DiseasesRepository) Update(diseasesData model.Diseases) error {
 		diseasesData.HadInfarctionOrStroke,
 		diseasesData.HasAtherosclerosis,
 		diseasesData.HasOtherCVD,
-	)
-	return err
+	).Scan(
+		&updated.UserID,
+		&updated.CVDPredisposed,
+		&updated.TakesStatins,
+		&updated.HasChronicKidneyDisease,
+		&updated.HasArterialHypertension,
+		&updated.HasIschemicHeartDisease,
+		&updated.HasTypeTwoDiabetes,
+		&updated.HadInfarctionOrStroke,
+		&updated.HasAtherosclerosis,
+		&updated.HasOtherCVD,
+	); err != nil {
+		// the record is created on the first access, so there is nothing to update before it
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	if err = saveRevision(queryCtx, tx, diseasesRevisionTable, updated.UserID, &updated); err != nil {
+		return err
+	}
+
+	return tx.Commit(queryCtx)
 }
 
 func (r *DiseasesRepository) Get(userID uint64) (*model.Diseases, error) {
@@ -175,3 +217,9 @@ func (r *DiseasesRepository) All() ([]model.Diseases, error) {
 
 	return diseasesData, nil
 }
+
+func (r *DiseasesRepository) Revisions(userID uint64) ([]*model.Revision, error) {
+	return r.storage.findRevisions(diseasesRevisionTable, userID, func() interface{} {
+		return &model.Diseases{}
+	})
+}
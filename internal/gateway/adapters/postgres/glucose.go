@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+const glucoseTable = "glucose_measurements"
+
+// check whether GlucoseRepository structure implements the storage.GlucoseRepository interface
+var _ storage.GlucoseRepository = (*GlucoseRepository)(nil)
+
+// GlucoseRepository implements storage.GlucoseRepository interface.
+type GlucoseRepository struct {
+	storage *Storage
+}
+
+func NewGlucoseRepository(storage *Storage) *GlucoseRepository {
+	return &GlucoseRepository{
+		storage: storage,
+	}
+}
+
+func (r *GlucoseRepository) Create(measurement model.GlucoseMeasurement) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %v (user_id, type, value, measured_at)
+		VALUES ($1, $2, $3, $4)`,
+		glucoseTable,
+	)
+	queryCtx := context.Background()
+
+	_, err := r.storage.conn.Exec(queryCtx, query,
+		measurement.UserID,
+		measurement.Type,
+		measurement.Value,
+		measurement.MeasuredAt.Time,
+	)
+	return err
+}
+
+func (r *GlucoseRepository) Delete(id, userID uint64) error {
+	query := fmt.Sprintf(`DELETE FROM %v WHERE id=$1 AND user_id=$2`, glucoseTable)
+	queryCtx := context.Background()
+
+	result, err := r.storage.conn.Exec(queryCtx, query, id, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *GlucoseRepository) FindAll(criteria model.GlucoseDiaryCriteria) ([]*model.GlucoseMeasurement, error) {
+	query := fmt.Sprintf(`
+		SELECT id,
+		       user_id,
+		       type,
+		       value,
+		       measured_at,
+		       created_at
+		FROM %v
+		WHERE user_id=$1 AND ($2='' OR type=$2) AND
+		      ($3::TIMESTAMP IS NULL OR measured_at >= $3) AND
+		      ($4::TIMESTAMP IS NULL OR measured_at < $4)
+		ORDER BY measured_at DESC, id DESC`,
+		glucoseTable,
+	)
+	queryCtx := context.Background()
+
+	var from, to *time.Time
+	if !criteria.From.IsZero() {
+		from = &criteria.From
+	}
+	if !criteria.To.IsZero() {
+		// the upper bound is inclusive, so the measurements of the whole day are included
+		nextDay := criteria.To.AddDate(0, 0, 1)
+		to = &nextDay
+	}
+
+	rows, err := r.storage.conn.Query(queryCtx, query, criteria.UserID, criteria.Type, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	measurements := make([]*model.GlucoseMeasurement, 0, 16)
+	for rows.Next() {
+		var measurement model.GlucoseMeasurement
+
+		if err = rows.Scan(
+			&measurement.ID,
+			&measurement.UserID,
+			&measurement.Type,
+			&measurement.Value,
+			&measurement.MeasuredAt.Time,
+			&measurement.CreatedAt.Time,
+		); err != nil {
+			return nil, err
+		}
+
+		measurements = append(measurements, &measurement)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return measurements, nil
+}
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	"github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+// check whether HypertensionRepository structure implements the storage.HypertensionRepository interface
+var _ storage.HypertensionRepository = (*HypertensionRepository)(nil)
+
+// HypertensionRepository implements storage.HypertensionRepository interface.
+type HypertensionRepository struct {
+	storage *Storage
+}
+
+func NewHypertensionRepository(storage *Storage) *HypertensionRepository {
+	return &HypertensionRepository{
+		storage: storage,
+	}
+}
+
+func (r *HypertensionRepository) FindAll(userID uint64) ([]*model.HypertensionClassification, error) {
+	query := fmt.Sprintf(`
+		SELECT category,
+		       systolic,
+		       diastolic,
+		       source,
+		       measured_at
+		FROM (SELECT hypertension_category                AS category,
+		             sbp_level::DOUBLE PRECISION          AS systolic,
+		             NULL::DOUBLE PRECISION               AS diastolic,
+		             '%[3]v'                              AS source,
+		             created_at                           AS measured_at
+		      FROM %[1]v
+		      WHERE user_id=$1 AND deleted_at IS NULL AND hypertension_category IS NOT NULL
+		      UNION ALL
+		      SELECT hypertension_category,
+		             systolic,
+		             diastolic,
+		             '%[4]v',
+		             measured_at
+		      FROM %[2]v
+		      WHERE user_id=$1 AND hypertension_category <> '') AS readings
+		ORDER BY measured_at DESC`,
+		basicIndicatorsTable, bloodPressureTable,
+		common.HypertensionSourceBasicIndicators, common.HypertensionSourceBloodPressure,
+	)
+	queryCtx := context.Background()
+
+	rows, err := r.storage.conn.Query(queryCtx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	classifications := make([]*model.HypertensionClassification, 0, 16)
+	for rows.Next() {
+		var classification model.HypertensionClassification
+
+		if err = rows.Scan(
+			&classification.Category,
+			&classification.Systolic,
+			&classification.Diastolic,
+			&classification.Source,
+			&classification.MeasuredAt.Time,
+		); err != nil {
+			return nil, err
+		}
+
+		classifications = append(classifications, &classification)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return classifications, nil
+}
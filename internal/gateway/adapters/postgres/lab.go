@@ -0,0 +1,306 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+const (
+	labTestTable   = "lab_tests"
+	labResultTable = "lab_results"
+)
+
+// PostgreSQL error codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pgErrorCodeForeignKeyViolation = "23503"
+	pgErrorCodeUniqueViolation     = "23505"
+)
+
+// check whether LabTestRepository structure implements the storage.LabTestRepository interface
+var _ storage.LabTestRepository = (*LabTestRepository)(nil)
+
+// LabTestRepository implements storage.LabTestRepository interface.
+type LabTestRepository struct {
+	storage *Storage
+}
+
+func NewLabTestRepository(storage *Storage) *LabTestRepository {
+	return &LabTestRepository{
+		storage: storage,
+	}
+}
+
+func (r *LabTestRepository) Create(labTest model.LabTest) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %v (code, name, unit, value_min, value_max)
+		VALUES ($1, $2, $3, $4, $5)`,
+		labTestTable,
+	)
+	queryCtx := context.Background()
+
+	_, err := r.storage.conn.Exec(queryCtx, query,
+		labTest.Code,
+		labTest.Name,
+		labTest.Unit,
+		labTest.Min,
+		labTest.Max,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgErrorCodeUniqueViolation {
+			return model.ErrLabTestAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *LabTestRepository) Update(labTest model.LabTest) error {
+	query := fmt.Sprintf(`
+		UPDATE %v
+		SET
+		    name=$2,
+		    value_min=$3,
+		    value_max=$4
+		WHERE code=$1`,
+		labTestTable,
+	)
+	queryCtx := context.Background()
+
+	result, err := r.storage.conn.Exec(queryCtx, query,
+		labTest.Code,
+		labTest.Name,
+		labTest.Min,
+		labTest.Max,
+	)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *LabTestRepository) Get(code string) (*model.LabTest, error) {
+	query := fmt.Sprintf(`
+		SELECT code,
+		       name,
+		       unit,
+		       value_min,
+		       value_max,
+		       built_in,
+		       created_at
+		FROM %v
+		WHERE code=$1`,
+		labTestTable,
+	)
+	queryCtx := context.Background()
+
+	var labTest model.LabTest
+	if err := r.storage.conn.QueryRow(
+		queryCtx, query, code,
+	).Scan(
+		&labTest.Code,
+		&labTest.Name,
+		&labTest.Unit,
+		&labTest.Min,
+		&labTest.Max,
+		&labTest.BuiltIn,
+		&labTest.CreatedAt.Time,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return &labTest, nil
+}
+
+func (r *LabTestRepository) Delete(code string) error {
+	query := fmt.Sprintf(`DELETE FROM %v WHERE code=$1`, labTestTable)
+	queryCtx := context.Background()
+
+	result, err := r.storage.conn.Exec(queryCtx, query, code)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgErrorCodeForeignKeyViolation {
+			return model.ErrLabTestInUse
+		}
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *LabTestRepository) FindAll() ([]*model.LabTest, error) {
+	query := fmt.Sprintf(`
+		SELECT code,
+		       name,
+		       unit,
+		       value_min,
+		       value_max,
+		       built_in,
+		       created_at
+		FROM %v
+		ORDER BY built_in DESC, code`,
+		labTestTable,
+	)
+	queryCtx := context.Background()
+
+	rows, err := r.storage.conn.Query(queryCtx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	labTests := make([]*model.LabTest, 0, 16)
+	for rows.Next() {
+		var labTest model.LabTest
+
+		if err = rows.Scan(
+			&labTest.Code,
+			&labTest.Name,
+			&labTest.Unit,
+			&labTest.Min,
+			&labTest.Max,
+			&labTest.BuiltIn,
+			&labTest.CreatedAt.Time,
+		); err != nil {
+			return nil, err
+		}
+
+		labTests = append(labTests, &labTest)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return labTests, nil
+}
+
+// check whether LabResultRepository structure implements the storage.LabResultRepository interface
+var _ storage.LabResultRepository = (*LabResultRepository)(nil)
+
+// LabResultRepository implements storage.LabResultRepository interface.
+type LabResultRepository struct {
+	storage *Storage
+}
+
+func NewLabResultRepository(storage *Storage) *LabResultRepository {
+	return &LabResultRepository{
+		storage: storage,
+	}
+}
+
+func (r *LabResultRepository) Create(userID uint64, results []*model.LabResult) error {
+	queryCtx := context.Background()
+
+	tx, err := r.storage.conn.Begin(queryCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(queryCtx)
+	}()
+
+	// the collection time of the analysis is the time of the earliest result
+	createdAt := results[0].CollectedAt.Time
+	for _, result := range results {
+		if result.CollectedAt.Before(createdAt) {
+			createdAt = result.CollectedAt.Time
+		}
+	}
+
+	query := fmt.Sprintf(
+		`INSERT INTO %v (user_id, created_at) VALUES ($1, $2) RETURNING id`,
+		analysisTable,
+	)
+
+	var analysisID uint64
+	if err = tx.QueryRow(queryCtx, query, userID, createdAt).Scan(&analysisID); err != nil {
+		return err
+	}
+
+	query = fmt.Sprintf(`
+		INSERT INTO %v (user_id, analysis_id, test_code, unit, value, collected_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		labResultTable,
+	)
+
+	for _, result := range results {
+		if _, err = tx.Exec(queryCtx, query,
+			userID,
+			analysisID,
+			result.TestCode,
+			result.Unit,
+			result.Value,
+			result.CollectedAt.Time,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(queryCtx)
+}
+
+func (r *LabResultRepository) FindAll(userID uint64, testCode string) ([]*model.LabResult, error) {
+	query := fmt.Sprintf(`
+		SELECT r.id,
+		       r.user_id,
+		       r.analysis_id,
+		       r.test_code,
+		       r.unit,
+		       r.value,
+		       r.collected_at
+		FROM %v r
+		         JOIN %v a ON a.id = r.analysis_id
+		WHERE r.user_id=$1 AND ($2='' OR r.test_code=$2) AND a.deleted_at IS NULL
+		ORDER BY r.collected_at DESC, r.id DESC`,
+		labResultTable, analysisTable,
+	)
+	queryCtx := context.Background()
+
+	rows, err := r.storage.conn.Query(queryCtx, query, userID, testCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := make([]*model.LabResult, 0, 16)
+	for rows.Next() {
+		var result model.LabResult
+
+		if err = rows.Scan(
+			&result.ID,
+			&result.UserID,
+			&result.AnalysisID,
+			&result.TestCode,
+			&result.Unit,
+			&result.Value,
+			&result.CollectedAt.Time,
+		); err != nil {
+			return nil, err
+		}
+
+		results = append(results, &result)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -26,6 +26,16 @@ func NewLifestyleRepository(storage *Storage) *LifestyleRepository {
 }
 
 func (r *LifestyleRepository) Update(lifestyleData model.Lifestyle) error {
+	queryCtx := context.Background()
+
+	tx, err := r.storage.conn.Begin(queryCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(queryCtx)
+	}()
+
 	query := fmt.Sprintf(`
 		UPDATE %v
         SET 
@@ -36,12 +46,20 @@ func (r *LifestyleRepository) Update(lifestyleData model.Lifestyle) error {
             significant_value_high=$6,
             significant_value_medium=$7,
             significant_value_low=$8
-        WHERE user_id=$1`,
+        WHERE user_id=$1
+        RETURNING user_id,
+                  family_status,
+                  events_participation,
+                  physical_activity,
+                  work_status,
+                  significant_value_high,
+                  significant_value_medium,
+                  significant_value_low`,
 		lifestyleTable,
 	)
-	queryCtx := context.Background()
 
-	_, err := r.storage.conn.Exec(queryCtx, query,
+	var updated model.Lifestyle
+	if err = tx.QueryRow(queryCtx, query,
 		lifestyleData.UserID,
 		lifestyleData.FamilyStatus,
 		lifestyleData.EventsParticipation,
@@ -50,8 +68,28 @@ func (r *LifestyleRepository) Update(lifestyleData model.Lifestyle) error {
 		lifestyleData.SignificantValueHigh,
 		lifestyleData.SignificantValueMedium,
 		lifestyleData.SignificantValueLow,
-	)
-	return err
+	).Scan(
+		&updated.UserID,
+		&updated.FamilyStatus,
+		&updated.EventsParticipation,
+		&updated.PhysicalActivity,
+		&updated.WorkStatus,
+		&updated.SignificantValueHigh,
+		&updated.SignificantValueMedium,
+		&updated.SignificantValueLow,
+	); err != nil {
+		// the record is created on the first access, so there is nothing to update before it
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	if err = saveRevision(queryCtx, tx, lifestyleRevisionTable, updated.UserID, &updated); err != nil {
+		return err
+	}
+
+	return tx.Commit(queryCtx)
 }
 
 func (r *LifestyleRepository) Get(userID uint64) (*model.Lifestyle, error) {
@@ -108,3 +146,9 @@ func (r *LifestyleRepository) Get(userID uint64) (*model.Lifestyle, error) {
 
 	return &lifestyleData, nil
 }
+
+func (r *LifestyleRepository) Revisions(userID uint64) ([]*model.Revision, error) {
+	return r.storage.findRevisions(lifestyleRevisionTable, userID, func() interface{} {
+		return &model.Lifestyle{}
+	})
+}
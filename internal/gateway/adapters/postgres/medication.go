@@ -0,0 +1,296 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+const (
+	medicationTable       = "medications"
+	medicationIntakeTable = "medication_intakes"
+)
+
+// check whether MedicationRepository structure implements the storage.MedicationRepository interface
+var _ storage.MedicationRepository = (*MedicationRepository)(nil)
+
+// MedicationRepository implements storage.MedicationRepository interface.
+type MedicationRepository struct {
+	storage *Storage
+}
+
+func NewMedicationRepository(storage *Storage) *MedicationRepository {
+	return &MedicationRepository{
+		storage: storage,
+	}
+}
+
+func (r *MedicationRepository) Create(medication model.Medication) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %v (user_id, name, drug_class, dose, doses_per_day, start_date, stop_date)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		medicationTable,
+	)
+	queryCtx := context.Background()
+
+	_, err := r.storage.conn.Exec(queryCtx, query,
+		medication.UserID,
+		medication.Name,
+		medication.Class,
+		medication.Dose,
+		medication.DosesPerDay,
+		medication.StartDate.Time,
+		medicationStopDate(medication),
+	)
+	return err
+}
+
+func (r *MedicationRepository) Update(medication model.Medication) error {
+	query := fmt.Sprintf(`
+		UPDATE %v
+		SET
+		    name=$3,
+		    drug_class=$4,
+		    dose=$5,
+		    doses_per_day=$6,
+		    start_date=$7,
+		    stop_date=$8
+		WHERE id=$1 AND user_id=$2`,
+		medicationTable,
+	)
+	queryCtx := context.Background()
+
+	result, err := r.storage.conn.Exec(queryCtx, query,
+		medication.ID,
+		medication.UserID,
+		medication.Name,
+		medication.Class,
+		medication.Dose,
+		medication.DosesPerDay,
+		medication.StartDate.Time,
+		medicationStopDate(medication),
+	)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// medicationStopDate returns the stop date of the medication as the query argument.
+func medicationStopDate(medication model.Medication) *time.Time {
+	if medication.StopDate == nil {
+		return nil
+	}
+	return &medication.StopDate.Time
+}
+
+func (r *MedicationRepository) Get(id, userID uint64) (*model.Medication, error) {
+	query := fmt.Sprintf(`
+		SELECT id,
+		       user_id,
+		       name,
+		       drug_class,
+		       dose,
+		       doses_per_day,
+		       start_date,
+		       stop_date,
+		       created_at
+		FROM %v
+		WHERE id=$1 AND user_id=$2`,
+		medicationTable,
+	)
+	queryCtx := context.Background()
+
+	medication, err := scanMedication(r.storage.conn.QueryRow(queryCtx, query, id, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return medication, nil
+}
+
+func (r *MedicationRepository) Delete(id, userID uint64) error {
+	query := fmt.Sprintf(`DELETE FROM %v WHERE id=$1 AND user_id=$2`, medicationTable)
+	queryCtx := context.Background()
+
+	result, err := r.storage.conn.Exec(queryCtx, query, id, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *MedicationRepository) FindAll(userID uint64) ([]*model.Medication, error) {
+	query := fmt.Sprintf(`
+		SELECT id,
+		       user_id,
+		       name,
+		       drug_class,
+		       dose,
+		       doses_per_day,
+		       start_date,
+		       stop_date,
+		       created_at
+		FROM %v
+		WHERE user_id=$1
+		ORDER BY start_date DESC, id DESC`,
+		medicationTable,
+	)
+	queryCtx := context.Background()
+
+	rows, err := r.storage.conn.Query(queryCtx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	medications := make([]*model.Medication, 0, 8)
+	for rows.Next() {
+		var medication *model.Medication
+		if medication, err = scanMedication(rows); err != nil {
+			return nil, err
+		}
+
+		medications = append(medications, medication)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return medications, nil
+}
+
+func scanMedication(row pgx.Row) (*model.Medication, error) {
+	var (
+		medication model.Medication
+		stopDate   *time.Time
+	)
+	if err := row.Scan(
+		&medication.ID,
+		&medication.UserID,
+		&medication.Name,
+		&medication.Class,
+		&medication.Dose,
+		&medication.DosesPerDay,
+		&medication.StartDate.Time,
+		&stopDate,
+		&medication.CreatedAt.Time,
+	); err != nil {
+		return nil, err
+	}
+
+	medication.SetStopDate(stopDate)
+
+	return &medication, nil
+}
+
+// check whether MedicationIntakeRepository structure implements the storage.MedicationIntakeRepository interface
+var _ storage.MedicationIntakeRepository = (*MedicationIntakeRepository)(nil)
+
+// MedicationIntakeRepository implements storage.MedicationIntakeRepository interface.
+type MedicationIntakeRepository struct {
+	storage *Storage
+}
+
+func NewMedicationIntakeRepository(storage *Storage) *MedicationIntakeRepository {
+	return &MedicationIntakeRepository{
+		storage: storage,
+	}
+}
+
+func (r *MedicationIntakeRepository) Create(intake model.MedicationIntake) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %v (user_id, medication_id, taken_at)
+		VALUES ($1, $2, $3)`,
+		medicationIntakeTable,
+	)
+	queryCtx := context.Background()
+
+	_, err := r.storage.conn.Exec(queryCtx, query, intake.UserID, intake.MedicationID, intake.TakenAt.Time)
+	return err
+}
+
+func (r *MedicationIntakeRepository) Delete(id, userID uint64) error {
+	query := fmt.Sprintf(`DELETE FROM %v WHERE id=$1 AND user_id=$2`, medicationIntakeTable)
+	queryCtx := context.Background()
+
+	result, err := r.storage.conn.Exec(queryCtx, query, id, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *MedicationIntakeRepository) FindAll(userID uint64, from, to time.Time) ([]*model.MedicationIntake, error) {
+	query := fmt.Sprintf(`
+		SELECT id,
+		       user_id,
+		       medication_id,
+		       taken_at
+		FROM %v
+		WHERE user_id=$1 AND
+		      ($2::TIMESTAMP IS NULL OR taken_at >= $2) AND
+		      ($3::TIMESTAMP IS NULL OR taken_at < $3)
+		ORDER BY taken_at DESC, id DESC`,
+		medicationIntakeTable,
+	)
+	queryCtx := context.Background()
+
+	var fromArg, toArg *time.Time
+	if !from.IsZero() {
+		fromArg = &from
+	}
+	if !to.IsZero() {
+		// the upper bound is inclusive, so the intakes of the whole day are included
+		nextDay := to.AddDate(0, 0, 1)
+		toArg = &nextDay
+	}
+
+	rows, err := r.storage.conn.Query(queryCtx, query, userID, fromArg, toArg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	intakes := make([]*model.MedicationIntake, 0, 16)
+	for rows.Next() {
+		var intake model.MedicationIntake
+
+		if err = rows.Scan(
+			&intake.ID,
+			&intake.UserID,
+			&intake.MedicationID,
+			&intake.TakenAt.Time,
+		); err != nil {
+			return nil, err
+		}
+
+		intakes = append(intakes, &intake)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return intakes, nil
+}
@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+const metabolicSyndromeTable = "metabolic_syndrome_screenings"
+
+// check whether MetabolicSyndromeRepository structure implements the storage.MetabolicSyndromeRepository interface
+var _ storage.MetabolicSyndromeRepository = (*MetabolicSyndromeRepository)(nil)
+
+// MetabolicSyndromeRepository implements storage.MetabolicSyndromeRepository interface.
+type MetabolicSyndromeRepository struct {
+	storage *Storage
+}
+
+func NewMetabolicSyndromeRepository(storage *Storage) *MetabolicSyndromeRepository {
+	return &MetabolicSyndromeRepository{
+		storage: storage,
+	}
+}
+
+func (r *MetabolicSyndromeRepository) Save(screening model.MetabolicSyndromeScreening) (uint64, error) {
+	definitions, err := json.Marshal(screening.Definitions)
+	if err != nil {
+		return 0, err
+	}
+
+	missingData, err := json.Marshal(screening.MissingData)
+	if err != nil {
+		return 0, err
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO %v (user_id, evaluation_date, status, definitions, missing_data)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, evaluation_date) DO UPDATE
+		SET
+		    status=EXCLUDED.status,
+		    definitions=EXCLUDED.definitions,
+		    missing_data=EXCLUDED.missing_data,
+		    updated_at=NOW()
+		RETURNING id`,
+		metabolicSyndromeTable,
+	)
+	queryCtx := context.Background()
+
+	var id uint64
+	if err = r.storage.conn.QueryRow(queryCtx, query,
+		screening.UserID,
+		screening.EvaluationDate.Time,
+		screening.Status,
+		definitions,
+		missingData,
+	).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *MetabolicSyndromeRepository) FindAll(userID uint64) ([]*model.MetabolicSyndromeScreening, error) {
+	query := fmt.Sprintf(`
+		SELECT id,
+		       user_id,
+		       evaluation_date,
+		       status,
+		       definitions,
+		       missing_data
+		FROM %v
+		WHERE user_id=$1
+		ORDER BY evaluation_date DESC`,
+		metabolicSyndromeTable,
+	)
+	queryCtx := context.Background()
+
+	rows, err := r.storage.conn.Query(queryCtx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	screenings := make([]*model.MetabolicSyndromeScreening, 0, 8)
+	for rows.Next() {
+		var (
+			screening   model.MetabolicSyndromeScreening
+			definitions []byte
+			missingData []byte
+		)
+
+		if err = rows.Scan(
+			&screening.ID,
+			&screening.UserID,
+			&screening.EvaluationDate.Time,
+			&screening.Status,
+			&definitions,
+			&missingData,
+		); err != nil {
+			return nil, err
+		}
+
+		if err = json.Unmarshal(definitions, &screening.Definitions); err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(missingData, &screening.MissingData); err != nil {
+			return nil, err
+		}
+
+		screenings = append(screenings, &screening)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return screenings, nil
+}
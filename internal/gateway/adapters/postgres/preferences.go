@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+const preferencesTable = "preferences"
+
+var _ storage.PreferencesRepository = (*PreferencesRepository)(nil)
+
+type PreferencesRepository struct {
+	storage *Storage
+}
+
+func NewPreferencesRepository(storage *Storage) *PreferencesRepository {
+	return &PreferencesRepository{
+		storage: storage,
+	}
+}
+
+func (r *PreferencesRepository) Update(preferencesData model.Preferences) error {
+	query := fmt.Sprintf(`
+		UPDATE %v
+        SET 
+            unit_system=$2
+        WHERE user_id=$1`,
+		preferencesTable,
+	)
+	queryCtx := context.Background()
+
+	_, err := r.storage.conn.Exec(queryCtx, query,
+		preferencesData.UserID,
+		preferencesData.UnitSystem,
+	)
+	return err
+}
+
+func (r *PreferencesRepository) Get(userID uint64) (*model.Preferences, error) {
+	query := fmt.Sprintf(
+		`
+		SELECT user_id,
+		       unit_system
+		FROM %v WHERE user_id=$1`,
+		preferencesTable,
+	)
+	queryCtx := context.Background()
+
+	var preferencesData model.Preferences
+	if err := r.storage.conn.QueryRow(
+		queryCtx, query, userID,
+	).Scan(
+		&preferencesData.UserID,
+		&preferencesData.UnitSystem,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			query = fmt.Sprintf(
+				`INSERT INTO %v (user_id) VALUES ($1) RETURNING user_id, unit_system`,
+				preferencesTable,
+			)
+
+			if err = r.storage.conn.QueryRow(queryCtx, query, userID).Scan(
+				&preferencesData.UserID,
+				&preferencesData.UnitSystem,
+			); err != nil {
+				return nil, err
+			}
+		} else {
+			return nil, err
+		}
+	}
+
+	return &preferencesData, nil
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -11,7 +12,10 @@ import (
 	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
 )
 
-const questionnaireTable = "questionnaire"
+const (
+	questionnaireTable    = "questionnaire"
+	anginaRoseResultTable = "angina_rose_results"
+)
 
 var _ storage.QuestionnaireRepository = (*QuestionnaireRepository)(nil)
 
@@ -71,40 +75,161 @@ func (r *QuestionnaireRepository) Get(userID uint64) (*model.Questionnaire, erro
 	return &questionnaire, nil
 }
 
-func (r *QuestionnaireRepository) UpdateAnginaRose(questionnaire model.Questionnaire) error {
-	query := fmt.Sprintf(`
-		UPDATE %v
-        SET 
-            angina_score=$2
-        WHERE user_id=$1`,
-		questionnaireTable,
-	)
+func (r *QuestionnaireRepository) SaveAnginaRose(result model.AnginaRoseResult) error {
+	answers, err := json.Marshal(result.Answers)
+	if err != nil {
+		return err
+	}
+
 	queryCtx := context.Background()
 
-	_, err := r.storage.conn.Exec(queryCtx, query,
-		questionnaire.UserID,
-		questionnaire.AnginaScore,
+	tx, err := r.storage.conn.Begin(queryCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(queryCtx)
+	}()
+
+	query := fmt.Sprintf(`
+		INSERT INTO %v (user_id, answers, classification, grade, possible_infarction, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		anginaRoseResultTable,
 	)
-	return err
+
+	if _, err = tx.Exec(queryCtx, query,
+		result.UserID,
+		answers,
+		result.Classification,
+		result.Grade,
+		result.PossibleInfarction,
+		result.CreatedAt.Time,
+	); err != nil {
+		return err
+	}
+
+	// the score is kept for the clients reading the questionnaire only
+	if err = r.update(queryCtx, tx, "angina_score=$2", result.UserID, result.Score()); err != nil {
+		return err
+	}
+
+	return tx.Commit(queryCtx)
 }
 
-func (r *QuestionnaireRepository) UpdateTreatmentAdherence(questionnaire model.Questionnaire) error {
+func (r *QuestionnaireRepository) FindAnginaRoseResults(userID uint64) ([]*model.AnginaRoseResult, error) {
 	query := fmt.Sprintf(`
-		UPDATE %v
-        SET 
-            adherence_drug_therapy=$2,
-            adherence_medical_support=$3,
-            adherence_lifestyle_mod=$4
-        WHERE user_id=$1`,
-		questionnaireTable,
+		SELECT id,
+		       user_id,
+		       answers,
+		       classification,
+		       grade,
+		       possible_infarction,
+		       created_at
+		FROM %v
+		WHERE user_id=$1
+		ORDER BY created_at DESC, id DESC`,
+		anginaRoseResultTable,
 	)
 	queryCtx := context.Background()
 
-	_, err := r.storage.conn.Exec(queryCtx, query,
+	rows, err := r.storage.conn.Query(queryCtx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := make([]*model.AnginaRoseResult, 0, 8)
+	for rows.Next() {
+		var (
+			result  model.AnginaRoseResult
+			answers []byte
+		)
+
+		if err = rows.Scan(
+			&result.ID,
+			&result.UserID,
+			&answers,
+			&result.Classification,
+			&result.Grade,
+			&result.PossibleInfarction,
+			&result.CreatedAt.Time,
+		); err != nil {
+			return nil, err
+		}
+
+		if err = json.Unmarshal(answers, &result.Answers); err != nil {
+			return nil, err
+		}
+
+		results = append(results, &result)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *QuestionnaireRepository) UpdateTreatmentAdherence(questionnaire model.Questionnaire) error {
+	queryCtx := context.Background()
+
+	tx, err := r.storage.conn.Begin(queryCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(queryCtx)
+	}()
+
+	if err = r.update(queryCtx, tx,
+		"adherence_drug_therapy=$2, adherence_medical_support=$3, adherence_lifestyle_mod=$4",
 		questionnaire.UserID,
 		questionnaire.AdherenceDrugTherapy,
 		questionnaire.AdherenceMedicalSupport,
 		questionnaire.AdherenceLifestyleMod,
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit(queryCtx)
+}
+
+// update sets the columns of the user questionnaire by the assignments, the first argument is the user id, and saves
+// the updated questionnaire to the revisions.
+func (r *QuestionnaireRepository) update(ctx context.Context, tx pgx.Tx, assignments string, userID uint64, args ...interface{}) error {
+	query := fmt.Sprintf(`
+		UPDATE %v
+        SET %v
+        WHERE user_id=$1
+        RETURNING user_id,
+                  angina_score,
+                  adherence_drug_therapy,
+                  adherence_medical_support,
+                  adherence_lifestyle_mod`,
+		questionnaireTable, assignments,
 	)
-	return err
+
+	var updated model.Questionnaire
+	if err := tx.QueryRow(ctx, query, append([]interface{}{userID}, args...)...).Scan(
+		&updated.UserID,
+		&updated.AnginaScore,
+		&updated.AdherenceDrugTherapy,
+		&updated.AdherenceMedicalSupport,
+		&updated.AdherenceLifestyleMod,
+	); err != nil {
+		// the questionnaire is created on the first access, so there is nothing to update before it
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	return saveRevision(ctx, tx, questionnaireRevisionTable, userID, &updated)
+}
+
+func (r *QuestionnaireRepository) Revisions(userID uint64) ([]*model.Revision, error) {
+	return r.storage.findRevisions(questionnaireRevisionTable, userID, func() interface{} {
+		return &model.Questionnaire{}
+	})
 }
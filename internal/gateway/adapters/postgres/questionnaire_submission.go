@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+const questionnaireSubmissionTable = "questionnaire_submissions"
+
+// check whether QuestionnaireSubmissionRepository structure implements the storage.QuestionnaireSubmissionRepository
+// interface
+var _ storage.QuestionnaireSubmissionRepository = (*QuestionnaireSubmissionRepository)(nil)
+
+// QuestionnaireSubmissionRepository implements storage.QuestionnaireSubmissionRepository interface.
+type QuestionnaireSubmissionRepository struct {
+	storage *Storage
+}
+
+func NewQuestionnaireSubmissionRepository(storage *Storage) *QuestionnaireSubmissionRepository {
+	return &QuestionnaireSubmissionRepository{
+		storage: storage,
+	}
+}
+
+func (r *QuestionnaireSubmissionRepository) Create(submission model.QuestionnaireSubmission) (uint64, error) {
+	answers, err := json.Marshal(submission.Answers)
+	if err != nil {
+		return 0, err
+	}
+
+	results, err := json.Marshal(submission.Results)
+	if err != nil {
+		return 0, err
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO %v (user_id, questionnaire_code, questionnaire_version, answers, results, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id`,
+		questionnaireSubmissionTable,
+	)
+	queryCtx := context.Background()
+
+	var id uint64
+	if err = r.storage.conn.QueryRow(queryCtx, query,
+		submission.UserID,
+		submission.Code,
+		submission.Version,
+		answers,
+		results,
+		submission.CreatedAt.Time,
+	).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *QuestionnaireSubmissionRepository) FindAll(userID uint64, code string) ([]*model.QuestionnaireSubmission, error) {
+	query := fmt.Sprintf(`
+		SELECT id,
+		       user_id,
+		       questionnaire_code,
+		       questionnaire_version,
+		       answers,
+		       results,
+		       created_at
+		FROM %v
+		WHERE user_id=$1 AND questionnaire_code=$2
+		ORDER BY created_at DESC, id DESC`,
+		questionnaireSubmissionTable,
+	)
+	queryCtx := context.Background()
+
+	rows, err := r.storage.conn.Query(queryCtx, query, userID, code)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	submissions := make([]*model.QuestionnaireSubmission, 0, 8)
+	for rows.Next() {
+		var (
+			submission model.QuestionnaireSubmission
+			answers    []byte
+			results    []byte
+		)
+
+		if err = rows.Scan(
+			&submission.ID,
+			&submission.UserID,
+			&submission.Code,
+			&submission.Version,
+			&answers,
+			&results,
+			&submission.CreatedAt.Time,
+		); err != nil {
+			return nil, err
+		}
+
+		if err = json.Unmarshal(answers, &submission.Answers); err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(results, &submission.Results); err != nil {
+			return nil, err
+		}
+
+		submissions = append(submissions, &submission)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return submissions, nil
+}
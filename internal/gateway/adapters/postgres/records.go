@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/model"
+)
+
+// querier is implemented by both the connections pool and the transaction.
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// recordsCriteriaCondition is the condition of the user records (analyses, basic indicators) listing, its arguments
+// are built by recordsCriteriaArgs.
+const recordsCriteriaCondition = `
+		WHERE user_id=$1 AND deleted_at IS NULL AND
+		      ($2::TIMESTAMP IS NULL OR created_at >= $2) AND
+		      ($3::TIMESTAMP IS NULL OR created_at < $3) AND
+		      ($4::BIGINT = 0 OR id < $4)
+		ORDER BY id DESC
+		LIMIT $5::BIGINT`
+
+// recordsCriteriaArgs builds the arguments of recordsCriteriaCondition. One record more than the criteria limit is
+// requested to find out whether the next page exists, see recordsNextCursor.
+func recordsCriteriaArgs(criteria model.RecordsCriteria) []interface{} {
+	var from, to *time.Time
+	if !criteria.From.IsZero() {
+		from = &criteria.From
+	}
+	if !criteria.To.IsZero() {
+		// the upper bound is inclusive, so the records of the whole day are included
+		nextDay := criteria.To.AddDate(0, 0, 1)
+		to = &nextDay
+	}
+
+	var limit *int64
+	if criteria.Limit > 0 {
+		limitWithNext := criteria.Limit + 1
+		limit = &limitWithNext
+	}
+
+	return []interface{}{criteria.UserID, from, to, criteria.Cursor, limit}
+}
+
+// recordsNextCursor returns the number of the records of the page and the cursor of the next page (0 if the page
+// is the last one) according to the number of the found records.
+func recordsNextCursor(criteria model.RecordsCriteria, found int, id func(i int) uint64) (int, uint64) {
+	if criteria.Limit <= 0 || int64(found) <= criteria.Limit {
+		return found, 0
+	}
+	return int(criteria.Limit), id(int(criteria.Limit) - 1)
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/model"
+)
+
+const (
+	diseasesRevisionTable      = "diseases_revisions"
+	lifestyleRevisionTable     = "lifestyles_revisions"
+	questionnaireRevisionTable = "questionnaire_revisions"
+)
+
+// saveRevision saves the JSON representation of the updated user record to the revisions table.
+func saveRevision(ctx context.Context, tx pgx.Tx, table string, userID uint64, record interface{}) error {
+	recordBytes, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf(`INSERT INTO %v (user_id, record) VALUES ($1, $2)`, table)
+
+	_, err = tx.Exec(ctx, query, userID, recordBytes)
+	return err
+}
+
+// findRevisions returns the revisions of the user record sorted from the newest to the oldest, the records are
+// unmarshalled to the values returned by newRecord.
+func (s *Storage) findRevisions(table string, userID uint64, newRecord func() interface{}) ([]*model.Revision, error) {
+	query := fmt.Sprintf(`
+		SELECT id,
+		       record,
+		       created_at
+		FROM %v
+		WHERE user_id=$1
+		ORDER BY id DESC`,
+		table,
+	)
+	queryCtx := context.Background()
+
+	rows, err := s.conn.Query(queryCtx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revisions := make([]*model.Revision, 0, 8)
+	for rows.Next() {
+		var (
+			record   []byte
+			revision model.Revision
+		)
+
+		if err = rows.Scan(&revision.ID, &record, &revision.CreatedAt.Time); err != nil {
+			return nil, err
+		}
+
+		revision.Record = newRecord()
+		if err = json.Unmarshal(record, revision.Record); err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, &revision)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
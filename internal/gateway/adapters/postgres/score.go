@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
@@ -23,24 +24,18 @@ func NewScoreRepository(storage *Storage) *ScoreRepository {
 	}
 }
 
-func (s *ScoreRepository) GetCVERisk(data model.ScoreData) (uint64, error) {
-	// TODO: replace 27-47 with custom CVE risk table builder, accepting CVE risk data
-	var tableNameBuilder strings.Builder
+// nonHDLCholesterolChartsMin is the lower bound of the lowest non-HDL cholesterol row of the risk charts.
+const nonHDLCholesterolChartsMin = 3.0
 
-	// table prefix depends on geo scope value (now it is only Russian scope, others will become later)
-	// TODO: generate dynamically by scope
-	tableNameBuilder.WriteString("very_high_risk")
+func (s *ScoreRepository) GetCVERisk(data model.ScoreData) (uint64, error) {
+	tablesPrefix, err := riskTablesPrefix(data)
+	if err != nil {
+		return 0, err
+	}
 
-	tableNameBuilder.WriteString("_")
+	var tableNameBuilder strings.Builder
 
-	switch data.Gender {
-	case common.UserGenderMale:
-		tableNameBuilder.WriteString("male")
-	case common.UserGenderFemale:
-		tableNameBuilder.WriteString("female")
-	default:
-		return 0, fmt.Errorf("unknown user gender: %v", data.Gender)
-	}
+	tableNameBuilder.WriteString(tablesPrefix)
 
 	tableNameBuilder.WriteString("_")
 
@@ -60,9 +55,13 @@ func (s *ScoreRepository) GetCVERisk(data model.ScoreData) (uint64, error) {
 	)
 	queryCtx := context.Background()
 
+	// values below the lowest chart row are assessed by this row
+	nonHDLCholesterolLevel, _ := data.NonHDLCholesterolLevel()
+	nonHDLCholesterolLevel = math.Max(nonHDLCholesterolLevel, nonHDLCholesterolChartsMin)
+
 	var riskValue uint64
-	if err := s.storage.conn.QueryRow(
-		queryCtx, query, data.SBPLevel, data.TotalCholesterolLevel, data.Age,
+	if err = s.storage.conn.QueryRow(
+		queryCtx, query, data.SBPLevel, nonHDLCholesterolLevel, data.Age,
 	).Scan(&riskValue); err != nil {
 		return 0, err
 	}
@@ -70,48 +69,28 @@ func (s *ScoreRepository) GetCVERisk(data model.ScoreData) (uint64, error) {
 	return riskValue, nil
 }
 
-func (s *ScoreRepository) GetIdealAge(cveRiskValue uint64, data model.ScoreData) (uint64, uint64, error) {
-	// TODO: replace 27-47 with custom CVE risk table builder, accepting CVE risk data
-	var tableNameBuilder strings.Builder
+// riskTablesPrefix builds the risk charts tables prefix, e.g. "very_high_risk_male", which depends on the risk scope
+// and user gender.
+func riskTablesPrefix(data model.ScoreData) (string, error) {
+	var prefixBuilder strings.Builder
 
-	// table prefix depends on geo scope value (now it is only Russian scope, others will become later)
-	// TODO: generate dynamically by scope
-	tableNameBuilder.WriteString("very_high_risk")
+	switch data.RiskScope {
+	case common.RiskScopeLow, common.RiskScopeModerate, common.RiskScopeHigh, common.RiskScopeVeryHigh:
+		prefixBuilder.WriteString(data.RiskScope)
+	default:
+		return "", fmt.Errorf("unknown risk scope: %v", data.RiskScope)
+	}
 
-	tableNameBuilder.WriteString("_")
+	prefixBuilder.WriteString("_risk_")
 
 	switch data.Gender {
 	case common.UserGenderMale:
-		tableNameBuilder.WriteString("male")
+		prefixBuilder.WriteString("male")
 	case common.UserGenderFemale:
-		tableNameBuilder.WriteString("female")
+		prefixBuilder.WriteString("female")
 	default:
-		return 0, 0, fmt.Errorf("unknown user gender: %v", data.Gender)
-	}
-
-	tablesPrefix := tableNameBuilder.String()
-
-	query := fmt.Sprintf(`
-         WITH %[1]v AS (SELECT age_min, age_max, risk_value
-                             FROM %[2]v
-                             UNION
-                             SELECT age_min, age_max, risk_value
-                             FROM %[3]v) 
-         SELECT age_min, age_max 
-         FROM %[1]v 
-         WHERE risk_value=$1 
-         ORDER BY age_min DESC, age_max DESC 
-         LIMIT 1`,
-		tablesPrefix, fmt.Sprintf("%v_smoking", tablesPrefix), fmt.Sprintf("%v_not_smoking", tablesPrefix),
-	)
-	queryCtx := context.Background()
-
-	var ageMin, ageMax uint64
-	if err := s.storage.conn.QueryRow(
-		queryCtx, query, cveRiskValue,
-	).Scan(&ageMin, &ageMax); err != nil {
-		return 0, 0, err
+		return "", fmt.Errorf("unknown user gender: %v", data.Gender)
 	}
 
-	return ageMin, ageMax, nil
+	return prefixBuilder.String(), nil
 }
@@ -15,12 +15,24 @@ var _ storage.Storage = (*Storage)(nil)
 type Storage struct {
 	conn *pgxpool.Pool
 
-	diseasesRepository        storage.DiseasesRepository
-	analysisRepository        storage.AnalysisRepository
-	lifestyleRepository       storage.LifestyleRepository
-	questionnaireRepository   storage.QuestionnaireRepository
-	basicIndicatorsRepository storage.BasicIndicatorsRepository
-	scoreRepository           storage.ScoreRepository
+	diseasesRepository                storage.DiseasesRepository
+	analysisRepository                storage.AnalysisRepository
+	lifestyleRepository               storage.LifestyleRepository
+	questionnaireRepository           storage.QuestionnaireRepository
+	basicIndicatorsRepository         storage.BasicIndicatorsRepository
+	scoreRepository                   storage.ScoreRepository
+	preferencesRepository             storage.PreferencesRepository
+	labTestRepository                 storage.LabTestRepository
+	labResultRepository               storage.LabResultRepository
+	trendsRepository                  storage.TrendsRepository
+	bloodPressureRepository           storage.BloodPressureRepository
+	hypertensionRepository            storage.HypertensionRepository
+	medicationRepository              storage.MedicationRepository
+	medicationIntakeRepository        storage.MedicationIntakeRepository
+	questionnaireSubmissionRepository storage.QuestionnaireSubmissionRepository
+	metabolicSyndromeRepository       storage.MetabolicSyndromeRepository
+	glucoseRepository                 storage.GlucoseRepository
+	activityRepository                storage.ActivityRepository
 }
 
 func NewStorage(dsn string) (*Storage, error) {
@@ -92,6 +104,126 @@ func (s *Storage) Score() storage.ScoreRepository {
 	return s.scoreRepository
 }
 
+func (s *Storage) Preferences() storage.PreferencesRepository {
+	if s.preferencesRepository != nil {
+		return s.preferencesRepository
+	}
+
+	s.preferencesRepository = NewPreferencesRepository(s)
+
+	return s.preferencesRepository
+}
+
+func (s *Storage) LabTests() storage.LabTestRepository {
+	if s.labTestRepository != nil {
+		return s.labTestRepository
+	}
+
+	s.labTestRepository = NewLabTestRepository(s)
+
+	return s.labTestRepository
+}
+
+func (s *Storage) LabResults() storage.LabResultRepository {
+	if s.labResultRepository != nil {
+		return s.labResultRepository
+	}
+
+	s.labResultRepository = NewLabResultRepository(s)
+
+	return s.labResultRepository
+}
+
+func (s *Storage) Trends() storage.TrendsRepository {
+	if s.trendsRepository != nil {
+		return s.trendsRepository
+	}
+
+	s.trendsRepository = NewTrendsRepository(s)
+
+	return s.trendsRepository
+}
+
+func (s *Storage) BloodPressure() storage.BloodPressureRepository {
+	if s.bloodPressureRepository != nil {
+		return s.bloodPressureRepository
+	}
+
+	s.bloodPressureRepository = NewBloodPressureRepository(s)
+
+	return s.bloodPressureRepository
+}
+
+func (s *Storage) Hypertension() storage.HypertensionRepository {
+	if s.hypertensionRepository != nil {
+		return s.hypertensionRepository
+	}
+
+	s.hypertensionRepository = NewHypertensionRepository(s)
+
+	return s.hypertensionRepository
+}
+
+func (s *Storage) Medications() storage.MedicationRepository {
+	if s.medicationRepository != nil {
+		return s.medicationRepository
+	}
+
+	s.medicationRepository = NewMedicationRepository(s)
+
+	return s.medicationRepository
+}
+
+func (s *Storage) MedicationIntakes() storage.MedicationIntakeRepository {
+	if s.medicationIntakeRepository != nil {
+		return s.medicationIntakeRepository
+	}
+
+	s.medicationIntakeRepository = NewMedicationIntakeRepository(s)
+
+	return s.medicationIntakeRepository
+}
+
+func (s *Storage) QuestionnaireSubmissions() storage.QuestionnaireSubmissionRepository {
+	if s.questionnaireSubmissionRepository != nil {
+		return s.questionnaireSubmissionRepository
+	}
+
+	s.questionnaireSubmissionRepository = NewQuestionnaireSubmissionRepository(s)
+
+	return s.questionnaireSubmissionRepository
+}
+
+func (s *Storage) MetabolicSyndrome() storage.MetabolicSyndromeRepository {
+	if s.metabolicSyndromeRepository != nil {
+		return s.metabolicSyndromeRepository
+	}
+
+	s.metabolicSyndromeRepository = NewMetabolicSyndromeRepository(s)
+
+	return s.metabolicSyndromeRepository
+}
+
+func (s *Storage) Glucose() storage.GlucoseRepository {
+	if s.glucoseRepository != nil {
+		return s.glucoseRepository
+	}
+
+	s.glucoseRepository = NewGlucoseRepository(s)
+
+	return s.glucoseRepository
+}
+
+func (s *Storage) Activity() storage.ActivityRepository {
+	if s.activityRepository != nil {
+		return s.activityRepository
+	}
+
+	s.activityRepository = NewActivityRepository(s)
+
+	return s.activityRepository
+}
+
 func (s *Storage) Close() error {
 	s.conn.Close()
 	return nil
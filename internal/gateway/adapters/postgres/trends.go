@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	"github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+// check whether TrendsRepository structure implements the storage.TrendsRepository interface
+var _ storage.TrendsRepository = (*TrendsRepository)(nil)
+
+// TrendsRepository implements storage.TrendsRepository interface.
+type TrendsRepository struct {
+	storage *Storage
+}
+
+func NewTrendsRepository(storage *Storage) *TrendsRepository {
+	return &TrendsRepository{
+		storage: storage,
+	}
+}
+
+func (r *TrendsRepository) Find(criteria model.TrendsCriteria) ([]*model.TrendSeries, error) {
+	// the measurements are the basic indicators values unpivoted to the metrics, the lab results, the glycaemic diary
+	// values and the blood pressure diary systolic values (along with the basic indicators SBP level), the slope of the linear trend is calculated by the least squares with the time in days
+	query := fmt.Sprintf(`
+		WITH measurements AS (
+		    SELECT m.metric,
+		           m.value,
+		           b.created_at AS measured_at
+		    FROM %[1]v b
+		             CROSS JOIN LATERAL (VALUES ('%[4]v'::TEXT, b.weight::DOUBLE PRECISION),
+		                                        ('%[5]v', b.body_mass_index),
+		                                        ('%[6]v', b.waist_size),
+		                                        ('%[7]v', b.sbp_level),
+		                                        ('%[8]v', b.total_cholesterol_level),
+		                                        ('%[9]v', b.cv_events_risk_value)) AS m (metric, value)
+		    WHERE b.user_id=$1 AND b.deleted_at IS NULL AND m.value IS NOT NULL
+		    UNION ALL
+		    SELECT r.test_code,
+		           r.value::DOUBLE PRECISION,
+		           r.collected_at
+		    FROM %[2]v r
+		             JOIN %[3]v a ON a.id = r.analysis_id
+		    WHERE r.user_id=$1 AND a.deleted_at IS NULL
+		    UNION ALL
+		    SELECT g.type,
+		           g.value::DOUBLE PRECISION,
+		           g.measured_at
+		    FROM %[10]v g
+		    WHERE g.user_id=$1
+		    UNION ALL
+		    SELECT '%[7]v',
+		           p.systolic::DOUBLE PRECISION,
+		           p.measured_at
+		    FROM %[11]v p
+		    WHERE p.user_id=$1
+		),
+		filtered AS (
+		    SELECT metric,
+		           value,
+		           measured_at
+		    FROM measurements
+		    WHERE (COALESCE(cardinality($2::TEXT[]), 0) = 0 OR metric = ANY ($2::TEXT[])) AND
+		          ($3::TIMESTAMP IS NULL OR measured_at >= $3) AND
+		          ($4::TIMESTAMP IS NULL OR measured_at < $4)
+		),
+		series AS (
+		    SELECT metric,
+		           regr_slope(value, EXTRACT(EPOCH FROM measured_at)::DOUBLE PRECISION / 86400) AS slope_per_day,
+		           (ARRAY_AGG(value ORDER BY measured_at))[1]      AS first_value,
+		           (ARRAY_AGG(value ORDER BY measured_at DESC))[1] AS last_value
+		    FROM filtered
+		    GROUP BY metric
+		),
+		buckets AS (
+		    SELECT metric,
+		           date_trunc($5::TEXT, measured_at) AS bucket_start,
+		           MIN(value)                         AS value_min,
+		           MAX(value)                         AS value_max,
+		           AVG(value)                         AS value_mean,
+		           COUNT(*)                           AS values_count
+		    FROM filtered
+		    GROUP BY metric, bucket_start
+		)
+		SELECT b.metric,
+		       b.bucket_start,
+		       b.value_min,
+		       b.value_max,
+		       b.value_mean,
+		       b.values_count,
+		       s.slope_per_day,
+		       s.first_value,
+		       s.last_value
+		FROM buckets b
+		         JOIN series s ON s.metric = b.metric
+		ORDER BY b.metric, b.bucket_start`,
+		basicIndicatorsTable, labResultTable, analysisTable,
+		common.TrendMetricWeight,
+		common.TrendMetricBodyMassIndex,
+		common.TrendMetricWaistSize,
+		common.TrendMetricSBPLevel,
+		common.TrendMetricTotalCholesterolLevel,
+		common.TrendMetricCVEventsRiskValue,
+		glucoseTable, bloodPressureTable,
+	)
+	queryCtx := context.Background()
+
+	metrics := criteria.Metrics
+	if metrics == nil {
+		metrics = []string{}
+	}
+
+	var from, to *time.Time
+	if !criteria.From.IsZero() {
+		from = &criteria.From
+	}
+	if !criteria.To.IsZero() {
+		// the upper bound is inclusive, so the measurements of the whole day are included
+		nextDay := criteria.To.AddDate(0, 0, 1)
+		to = &nextDay
+	}
+
+	rows, err := r.storage.conn.Query(queryCtx, query, criteria.UserID, metrics, from, to, criteria.Period)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	series := make([]*model.TrendSeries, 0, 16)
+	var current *model.TrendSeries
+	for rows.Next() {
+		var (
+			metric string
+			bucket model.TrendBucket
+			slope  *float64
+			first  float64
+			last   float64
+		)
+
+		if err = rows.Scan(
+			&metric,
+			&bucket.Start.Time,
+			&bucket.Min,
+			&bucket.Max,
+			&bucket.Mean,
+			&bucket.Count,
+			&slope,
+			&first,
+			&last,
+		); err != nil {
+			return nil, err
+		}
+
+		// the rows are sorted by the metric, so the buckets of the same series are adjacent
+		if current == nil || current.Metric != metric {
+			current = &model.TrendSeries{
+				Metric:           metric,
+				Buckets:          make([]*model.TrendBucket, 0, 8),
+				SlopePerDay:      slope,
+				First:            first,
+				Last:             last,
+				ChangeSinceFirst: last - first,
+			}
+			series = append(series, current)
+		}
+
+		current.Buckets = append(current.Buckets, &bucket)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return series, nil
+}
@@ -1,11 +1,15 @@
 package config
 
 import (
+	"fmt"
 	"io"
 	"os"
 
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	"github.com/cardio-analyst/backend/internal/gateway/domain/model"
 )
 
 const (
@@ -15,11 +19,14 @@ const (
 )
 
 type Config struct {
-	Gateway         GatewayConfig         `yaml:"gateway"`
-	Postgres        PostgresConfig        `yaml:"postgres"`
-	RabbitMQ        RabbitMQConfig        `yaml:"rabbitmq"`
-	Recommendations RecommendationsConfig `yaml:"recommendations"`
-	Services        ServicesConfig        `yaml:"services"`
+	Gateway         GatewayConfig                    `yaml:"gateway"`
+	Postgres        PostgresConfig                   `yaml:"postgres"`
+	RabbitMQ        RabbitMQConfig                   `yaml:"rabbitmq"`
+	Recommendations RecommendationsConfig            `yaml:"recommendations"`
+	Score           ScoreConfig                      `yaml:"score"`
+	ReferenceRanges []ReferenceRange                 `yaml:"reference_ranges"`
+	Questionnaires  []*model.QuestionnaireDefinition `yaml:"questionnaires"`
+	Services        ServicesConfig                   `yaml:"services"`
 }
 
 type GatewayConfig struct {
@@ -48,19 +55,30 @@ type RabbitMQQueueConfig struct {
 }
 
 type RecommendationsConfig struct {
-	HealthyEating    RecommendationConfig `yaml:"healthy_eating"`
-	Smoking          RecommendationConfig `yaml:"smoking"`
-	Lifestyle        RecommendationConfig `yaml:"lifestyle"`
-	BMI              RecommendationConfig `yaml:"bmi"`
-	CholesterolLevel RecommendationConfig `yaml:"cholesterol_level"`
-	SBPLevel         RecommendationConfig `yaml:"sbp_level"`
-	Risk             RecommendationConfig `yaml:"risk"`
+	Rules []*model.RecommendationRule `yaml:"rules"`
+}
+
+type ScoreConfig struct {
+	RiskModel        string            `yaml:"risk_model"`
+	DefaultRiskScope string            `yaml:"default_risk_scope"`
+	RegionRiskScopes map[string]string `yaml:"region_risk_scopes"`
 }
 
-type RecommendationConfig struct {
-	What string `yaml:"what"`
-	Why  string `yaml:"why"`
-	How  string `yaml:"how"`
+// ReferenceRange describes the statuses of the analysis value for the gender and age. Empty gender and zero ages
+// mean no restrictions.
+type ReferenceRange struct {
+	Value  string               `yaml:"value"`
+	Gender string               `yaml:"gender"`
+	AgeMin int                  `yaml:"age_min"`
+	AgeMax int                  `yaml:"age_max"`
+	Bands  []ReferenceRangeBand `yaml:"bands"`
+}
+
+// ReferenceRangeBand describes the status of the values in [Min, Max) range. Nil bounds mean no restrictions.
+type ReferenceRangeBand struct {
+	Status string   `yaml:"status"`
+	Min    *float64 `yaml:"min"`
+	Max    *float64 `yaml:"max"`
 }
 
 type ServicesConfig struct {
@@ -90,9 +108,83 @@ func Load(configPath string) (Config, error) {
 
 	cfg.loadFromEnv()
 
+	if err = cfg.validateQuestionnaires(); err != nil {
+		return Config{}, err
+	}
+
+	if err = cfg.validateRecommendations(); err != nil {
+		return Config{}, err
+	}
+
+	if err = cfg.validateScore(); err != nil {
+		return Config{}, err
+	}
+
 	return cfg, nil
 }
 
+// validateQuestionnaires checks the questionnaires definitions, so the misconfigured questionnaire is found at the
+// start rather than at the submission.
+func (c *Config) validateQuestionnaires() error {
+	codes := make(map[string]bool, len(c.Questionnaires))
+	for _, questionnaire := range c.Questionnaires {
+		if err := questionnaire.Validate(); err != nil {
+			return err
+		}
+
+		if codes[questionnaire.Code] {
+			return fmt.Errorf("duplicate questionnaire %v", questionnaire.Code)
+		}
+		// the routes of the questionnaire would be shadowed by the dedicated ones
+		if questionnaire.Code == common.QuestionnaireCodeAnginaRose ||
+			questionnaire.Code == common.QuestionnaireCodeTreatmentAdherence {
+			return fmt.Errorf("questionnaire %v is served by the dedicated routes", questionnaire.Code)
+		}
+		codes[questionnaire.Code] = true
+	}
+	return nil
+}
+
+// validateRecommendations checks the recommendation rules and parses their texts templates.
+func (c *Config) validateRecommendations() error {
+	codes := make(map[string]bool, len(c.Recommendations.Rules))
+	for _, rule := range c.Recommendations.Rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+
+		if codes[rule.Code] {
+			return fmt.Errorf("duplicate recommendation rule %v", rule.Code)
+		}
+		codes[rule.Code] = true
+	}
+	return nil
+}
+
+// validateScore checks the risk scopes of the regions, so the mistyped scope is found at the start rather than at
+// the risk charts lookup. Empty default scope means the very high risk scope.
+func (c *Config) validateScore() error {
+	if c.Score.DefaultRiskScope != "" && !isRiskScope(c.Score.DefaultRiskScope) {
+		return fmt.Errorf("unknown default risk scope %q", c.Score.DefaultRiskScope)
+	}
+
+	for region, riskScope := range c.Score.RegionRiskScopes {
+		if !isRiskScope(riskScope) {
+			return fmt.Errorf("region %v: unknown risk scope %q", region, riskScope)
+		}
+	}
+	return nil
+}
+
+func isRiskScope(riskScope string) bool {
+	switch riskScope {
+	case common.RiskScopeLow, common.RiskScopeModerate, common.RiskScopeHigh, common.RiskScopeVeryHigh:
+		return true
+	default:
+		return false
+	}
+}
+
 func (c *Config) loadFromEnv() {
 	// if dsn was set at the environment
 	if dsnFromEnv, exists := os.LookupEnv(databaseURLEnvKey); exists {
@@ -27,3 +27,388 @@ const (
 	ScaleNeutral  = "neutral"
 	ScaleNegative = "negative"
 )
+
+// possible SCORE risk scope values, each one selects its own set of risk charts
+const (
+	RiskScopeLow      = "low"
+	RiskScopeModerate = "moderate"
+	RiskScopeHigh     = "high"
+	RiskScopeVeryHigh = "very_high"
+)
+
+// possible SCORE risk models
+const (
+	RiskModelCharts = "charts" // SCORE2 and SCORE2-OP charts stored in the database
+	RiskModelSCORE2 = "score2" // SCORE2 and SCORE2-OP equations
+)
+
+// possible model.RiskFactorContribution Factor values, the modifiable SCORE risk factors
+const (
+	RiskFactorSmoking     = "smoking"
+	RiskFactorSBPLevel    = "sbpLevel"
+	RiskFactorCholesterol = "cholesterol"
+)
+
+// model.Analysis values names, used by ComputedValues, Flags and Units
+const (
+	AnalysisValueHighDensityCholesterol            = "highDensityCholesterol"
+	AnalysisValueLowDensityCholesterol             = "lowDensityCholesterol"
+	AnalysisValueTriglycerides                     = "triglycerides"
+	AnalysisValueLipoprotein                       = "lipoprotein"
+	AnalysisValueHighlySensitiveCReactiveProtein   = "highlySensitiveCReactiveProtein"
+	AnalysisValueAtherogenicityCoefficient         = "atherogenicityCoefficient"
+	AnalysisValueCreatinine                        = "creatinine"
+	AnalysisValueEstimatedGlomerularFiltrationRate = "estimatedGlomerularFiltrationRate"
+	AnalysisValueAtheroscleroticPlaquesPresence    = "atheroscleroticPlaquesPresence"
+)
+
+// possible model.Preferences UnitSystem values
+const (
+	UnitSystemSI = "si" // mmol/L, kg, cm
+	UnitSystemUS = "us" // mg/dL, lb, in
+)
+
+// possible model.Units values
+const (
+	UnitMillimolesPerLiter     = "mmol/L"
+	UnitMicromolesPerLiter     = "umol/L"
+	UnitMilligramsPerDeciliter = "mg/dL"
+	UnitMilligramsPerLiter     = "mg/L"
+	UnitGramsPerLiter          = "g/L"
+	UnitKilogram               = "kg"
+	UnitPound                  = "lb"
+	UnitCentimeter             = "cm"
+	UnitInch                   = "in"
+	UnitPercent                = "%"
+)
+
+// possible model.Analysis Flags values
+const (
+	ReferenceStatusLow        = "low"
+	ReferenceStatusNormal     = "normal"
+	ReferenceStatusBorderline = "borderline"
+	ReferenceStatusHigh       = "high"
+	ReferenceStatusCritical   = "critical"
+)
+
+// possible model.TrendsCriteria Period values
+const (
+	TrendPeriodDay   = "day"
+	TrendPeriodWeek  = "week"
+	TrendPeriodMonth = "month"
+)
+
+// model.BasicIndicators values names available as the trends metrics, the lab tests codes are available as well; the
+// SBP level includes the blood pressure diary systolic values
+const (
+	TrendMetricWeight                = "weight"
+	TrendMetricBodyMassIndex         = "bodyMassIndex"
+	TrendMetricWaistSize             = "waistSize"
+	TrendMetricSBPLevel              = "sbpLevel"
+	TrendMetricTotalCholesterolLevel = "totalCholesterolLevel"
+	TrendMetricCVEventsRiskValue     = "cvEventsRiskValue"
+)
+
+// possible model.BloodPressureMeasurement Arm values
+const (
+	BloodPressureArmLeft  = "left"
+	BloodPressureArmRight = "right"
+)
+
+// possible model.BloodPressureMeasurement Position values
+const (
+	BloodPressurePositionSitting  = "sitting"
+	BloodPressurePositionStanding = "standing"
+	BloodPressurePositionLying    = "lying"
+)
+
+// possible model.BloodPressureMeasurement TimeOfDay values
+const (
+	BloodPressureTimeOfDayMorning = "morning"
+	BloodPressureTimeOfDayEvening = "evening"
+)
+
+// possible model.HypertensionClassification Category values, see ESC/ESH guidelines
+const (
+	HypertensionCategoryOptimal          = "optimal"
+	HypertensionCategoryNormal           = "normal"
+	HypertensionCategoryHighNormal       = "highNormal"
+	HypertensionCategoryGrade1           = "grade1"
+	HypertensionCategoryGrade2           = "grade2"
+	HypertensionCategoryGrade3           = "grade3"
+	HypertensionCategoryIsolatedSystolic = "isolatedSystolic"
+)
+
+// possible model.HypertensionClassification Transition values
+const (
+	HypertensionTransitionWorsened = "worsened"
+	HypertensionTransitionImproved = "improved"
+)
+
+// possible model.HypertensionClassification Source values
+const (
+	HypertensionSourceBasicIndicators = "basicIndicators"
+	HypertensionSourceBloodPressure   = "bloodPressure"
+)
+
+// possible model.Medication Class values
+const (
+	MedicationClassStatin           = "statin"
+	MedicationClassEzetimibe        = "ezetimibe"
+	MedicationClassPCSK9Inhibitor   = "pcsk9Inhibitor"
+	MedicationClassFibrate          = "fibrate"
+	MedicationClassBempedoicAcid    = "bempedoicAcid"
+	MedicationClassAntihypertensive = "antihypertensive"
+	MedicationClassAntiplatelet     = "antiplatelet"
+	MedicationClassAnticoagulant    = "anticoagulant"
+	MedicationClassAntidiabetic     = "antidiabetic"
+	MedicationClassOther            = "other"
+)
+
+// possible model.AnginaRoseAnswers PainUphillOrHurry values
+const (
+	AnginaRoseAnswerYes   = "yes"
+	AnginaRoseAnswerNo    = "no"
+	AnginaRoseAnswerNever = "never" // never walks uphill or hurries
+)
+
+// possible model.AnginaRoseAnswers ActionWhileWalking values
+const (
+	AnginaRoseActionStop    = "stop" // stops or slows down, or carries on after taking nitroglycerin
+	AnginaRoseActionCarryOn = "carryOn"
+)
+
+// possible model.AnginaRoseAnswers PainSites values
+const (
+	AnginaRosePainSiteSternumUpper      = "sternumUpper"
+	AnginaRosePainSiteSternumLower      = "sternumLower"
+	AnginaRosePainSiteLeftAnteriorChest = "leftAnteriorChest"
+	AnginaRosePainSiteLeftArm           = "leftArm"
+	AnginaRosePainSiteOther             = "other"
+)
+
+// possible model.AnginaRoseResult Classification values
+const (
+	AnginaRoseClassificationNone     = "none"
+	AnginaRoseClassificationPossible = "possible"
+	AnginaRoseClassificationDefinite = "definite"
+)
+
+// the questionnaires served by the dedicated routes rather than by the declarative questionnaires: the Rose angina
+// classification is not the sum or the mean of the answers scores, the treatment adherence scales are calculated by
+// the client; their codes can't be used by the questionnaires defined in the config
+const (
+	QuestionnaireCodeAnginaRose         = "angina-rose"
+	QuestionnaireCodeTreatmentAdherence = "treatment-adherence"
+)
+
+// possible model.QuestionnaireQuestion Type values
+const (
+	QuestionTypeSingle   = "single"   // exactly one option is chosen
+	QuestionTypeMultiple = "multiple" // one or more options are chosen
+)
+
+// possible model.QuestionnaireScale Method values
+const (
+	ScaleMethodSum  = "sum"  // the sum of the scores of the chosen options
+	ScaleMethodMean = "mean" // the mean of the scores of the answered questions
+)
+
+// possible model.RecommendationCondition Operator values
+const (
+	ConditionOperatorEq     = "eq"
+	ConditionOperatorNe     = "ne"
+	ConditionOperatorGt     = "gt"
+	ConditionOperatorGte    = "gte"
+	ConditionOperatorLt     = "lt"
+	ConditionOperatorLte    = "lte"
+	ConditionOperatorIn     = "in"
+	ConditionOperatorExists = "exists"
+)
+
+// recommendation facts names derived from the patient data, see model.RecommendationPatient Facts; the diseases,
+// lifestyle, questionnaire and analysis values are named as in their JSON representations
+const (
+	FactAge                         = "age"
+	FactGender                      = "gender"
+	FactSmoking                     = "smoking"
+	FactSBPLevel                    = "sbpLevel"
+	FactSBPCategory                 = "sbpCategory"
+	FactHypertension                = "hypertension"
+	FactTotalCholesterolLevel       = "totalCholesterolLevel"
+	FactHDLCholesterolLevel         = "hdlCholesterolLevel"
+	FactWeight                      = "weight"
+	FactHeight                      = "height"
+	FactWaistSize                   = "waistSize"
+	FactBodyMassIndex               = "bodyMassIndex"
+	FactRiskValue                   = "riskValue"
+	FactRiskValueNotSmoking         = "riskValueNotSmoking"
+	FactRiskApproximate             = "riskApproximate"
+	FactCardiovascularAge           = "cardiovascularAge"
+	FactCardiovascularAgeDifference = "cardiovascularAgeDifference"
+	FactRiskFactors                 = "riskFactors"
+	FactRiskCategory                = "riskCategory"       // see model.ClassifyRiskCategory
+	FactRiskCategoryName            = "riskCategoryName"   // the Russian name of the risk category
+	FactRiskCategoryReason          = "riskCategoryReason" // the description of the rule the risk category is classified by
+	// see model.EvaluateLipidManagement
+	FactLDLCholesterolGoal            = "ldlCholesterolGoal"
+	FactLDLCholesterolGoalAttained    = "ldlCholesterolGoalAttained"
+	FactLDLCholesterolReductionNeeded = "ldlCholesterolReductionNeeded"
+	FactLipidTherapy                  = "lipidTherapy"
+	// see model.ScreenMetabolicSyndrome
+	FactMetabolicSyndrome            = "metabolicSyndrome" // the screening status
+	FactMetabolicSyndromeCriteria    = "metabolicSyndromeCriteria"
+	FactMetabolicSyndromeCriteriaMet = "metabolicSyndromeCriteriaMet"
+	FactMetabolicSyndromeMissingData = "metabolicSyndromeMissingData"
+	// see model.EvaluateGlycaemicControl, the latest values are named as the model.GlucoseMeasurement Type values
+	FactGlycaemicTargetAttained        = "glycaemicTargetAttained"
+	FactGlycaemicIndicatorsAboveTarget = "glycaemicIndicatorsAboveTarget"
+	FactGlycatedHemoglobinTarget       = "glycatedHemoglobinTarget"
+	FactRecentHypoglycaemiaCount       = "recentHypoglycaemiaCount"
+	// see model.SummarizeActivity, the activity log facts are evaluated over the last 7 days
+	FactWeeklyActivityMinutes = "weeklyActivityMinutes" // the moderate-equivalent minutes
+	FactWeeklyMETMinutes      = "weeklyMetMinutes"
+	FactActivityTarget        = "activityTarget"
+	// expected risk reductions in percentage points if the modifiable risk factor is brought to its ideal value
+	FactSmokingRiskReduction     = "smokingRiskReduction"
+	FactSBPLevelRiskReduction    = "sbpLevelRiskReduction"
+	FactCholesterolRiskReduction = "cholesterolRiskReduction"
+)
+
+// possible model.Recommendation Category values
+const (
+	RecommendationCategoryBehaviour      = "behaviour"
+	RecommendationCategoryBloodPressure  = "bloodPressure"
+	RecommendationCategoryLipids         = "lipids"
+	RecommendationCategoryBodyWeight     = "bodyWeight"
+	RecommendationCategoryRiskAssessment = "riskAssessment"
+	RecommendationCategoryInflammation   = "inflammation"
+	RecommendationCategoryKidney         = "kidney"
+	RecommendationCategoryMetabolic      = "metabolic"
+	RecommendationCategoryDiabetes       = "diabetes"
+)
+
+// possible model.RiskCategory Category values, the ESC cardiovascular risk categories
+const (
+	RiskCategoryLow      = "low"
+	RiskCategoryModerate = "moderate"
+	RiskCategoryHigh     = "high"
+	RiskCategoryVeryHigh = "veryHigh"
+)
+
+// possible model.RiskCategory Rule values in the order of the application, see model.ClassifyRiskCategory
+const (
+	RiskCategoryRuleASCVD                         = "ascvd"
+	RiskCategoryRuleAtheroscleroticPlaques        = "atheroscleroticPlaques"
+	RiskCategoryRuleSevereCKD                     = "severeCKD"
+	RiskCategoryRuleDiabetesWithTargetOrganDamage = "diabetesWithTargetOrganDamage"
+	RiskCategoryRuleScoreVeryHigh                 = "scoreVeryHigh"
+	RiskCategoryRuleMarkedlyElevatedCholesterol   = "markedlyElevatedCholesterol"
+	RiskCategoryRuleMarkedlyElevatedLDL           = "markedlyElevatedLDL"
+	RiskCategoryRuleMarkedlyElevatedSBP           = "markedlyElevatedSBP"
+	RiskCategoryRuleDiabetes                      = "diabetes"
+	RiskCategoryRuleModerateCKD                   = "moderateCKD"
+	RiskCategoryRuleScoreHigh                     = "scoreHigh"
+	RiskCategoryRuleScoreModerate                 = "scoreModerate"
+	RiskCategoryRuleScoreLow                      = "scoreLow"
+)
+
+// possible model.LipidManagement Therapy values
+const (
+	LipidTherapyUnknown      = "unknown"      // LDL cholesterol is unknown
+	LipidTherapyNotIndicated = "notIndicated" // the goal is attained without statins
+	LipidTherapyLifestyle    = "lifestyle"    // the lifestyle intervention, the drugs are considered if it is not enough
+	LipidTherapyStart        = "start"        // the candidate for starting the statin therapy
+	LipidTherapyContinue     = "continue"     // the goal is attained on statins
+	LipidTherapyIntensify    = "intensify"    // the goal is not attained on statins
+)
+
+// built-in model.LabTest Code values which are not the model.Analysis values
+const (
+	LabTestGlucose            = "glucose"
+	LabTestGlycatedHemoglobin = "glycatedHemoglobin"
+)
+
+// possible model.MetabolicSyndromeDefinitionResult Definition values
+const (
+	MetabolicSyndromeDefinitionNCEP = "ncepAtpIII" // NCEP ATP III (AHA/NHLBI 2005 revision)
+	MetabolicSyndromeDefinitionIDF  = "idf"        // IDF 2005, the waist thresholds for Europids
+)
+
+// possible model.MetabolicSyndromeDefinitionResult Status values
+const (
+	MetabolicSyndromePresent       = "present"
+	MetabolicSyndromeAbsent        = "absent"
+	MetabolicSyndromeIndeterminate = "indeterminate" // the missing data may change the result
+)
+
+// possible model.MetabolicSyndromeCriterion Code values
+const (
+	MetabolicSyndromeCriterionWaistSize      = "waistSize"
+	MetabolicSyndromeCriterionTriglycerides  = "triglycerides"
+	MetabolicSyndromeCriterionHDLCholesterol = "hdlCholesterol"
+	MetabolicSyndromeCriterionBloodPressure  = "bloodPressure"
+	MetabolicSyndromeCriterionGlucose        = "glucose"
+)
+
+// possible model.MetabolicSyndromeCriterion Status values
+const (
+	MetabolicSyndromeCriterionMet     = "met"
+	MetabolicSyndromeCriterionNotMet  = "notMet"
+	MetabolicSyndromeCriterionMissing = "missing"
+)
+
+// possible model.MetabolicSyndromeScreening MissingData values
+const (
+	MetabolicSyndromeDataGender         = "gender"
+	MetabolicSyndromeDataWaistSize      = "waistSize"
+	MetabolicSyndromeDataTriglycerides  = "triglycerides"
+	MetabolicSyndromeDataHDLCholesterol = "highDensityCholesterol"
+	MetabolicSyndromeDataSBPLevel       = "sbpLevel"
+	MetabolicSyndromeDataDBPLevel       = "dbpLevel"
+	MetabolicSyndromeDataGlucose        = "glucose"
+)
+
+// possible model.GlucoseMeasurement Type values, the values are also available as the trends metrics
+const (
+	GlucoseMeasurementFasting            = "fastingGlucose"
+	GlucoseMeasurementPostprandial       = "postprandialGlucose"
+	GlucoseMeasurementGlycatedHemoglobin = "glycatedHemoglobin"
+)
+
+// possible model.GlycaemicIndicator Status values
+const (
+	GlycaemicStatusUnknown       = "unknown"
+	GlycaemicStatusOnTarget      = "onTarget"
+	GlycaemicStatusAboveTarget   = "aboveTarget"
+	GlycaemicStatusHypoglycaemia = "hypoglycaemia"
+)
+
+// possible model.ActivityEntry Type values, see model.ActivityCompendium
+const (
+	ActivityTypeWalking          = "walking"
+	ActivityTypeRunning          = "running"
+	ActivityTypeCycling          = "cycling"
+	ActivityTypeSwimming         = "swimming"
+	ActivityTypeSkiing           = "skiing"
+	ActivityTypeDancing          = "dancing"
+	ActivityTypeTeamSports       = "teamSports"
+	ActivityTypeStrengthTraining = "strengthTraining"
+	ActivityTypeYoga             = "yoga"
+	ActivityTypeGardening        = "gardening"
+	ActivityTypeHousework        = "housework"
+)
+
+// possible model.ActivityEntry Intensity values
+const (
+	ActivityIntensityLight    = "light"
+	ActivityIntensityModerate = "moderate"
+	ActivityIntensityVigorous = "vigorous"
+)
+
+// possible model.ActivitySummary TargetStatus values, see WHO physical activity guidelines
+const (
+	ActivityTargetBelow  = "belowTarget"  // less than 150 moderate-equivalent minutes a week
+	ActivityTargetWithin = "withinTarget" // from 150 to 300 minutes
+	ActivityTargetAbove  = "aboveTarget"  // more than 300 minutes, the additional health benefits
+)
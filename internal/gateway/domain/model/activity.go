@@ -0,0 +1,344 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+var (
+	ErrInvalidActivityType        = errors.New("invalid activity type")
+	ErrInvalidActivityIntensity   = errors.New("invalid activity intensity")
+	ErrInvalidActivityDuration    = errors.New("invalid activity duration")
+	ErrInvalidActivityData        = errors.New("invalid activity data")
+	ErrActivityEntryNotFound      = errors.New("activity entry with this id not found")
+	ErrInvalidActivityLogCriteria = errors.New("invalid activity log criteria")
+)
+
+// WHO physical activity guidelines: 150-300 minutes of the moderate-intensity aerobic activity a week, the minute of
+// the vigorous activity is equivalent to two minutes of the moderate one.
+const (
+	ActivityTargetMinMinutes = 150
+	ActivityTargetMaxMinutes = 300
+)
+
+// the MET values bounds of the moderate and the vigorous intensity activities
+const (
+	moderateActivityMinMET = 3
+	vigorousActivityMinMET = 6
+)
+
+// activityMETs is the built-in compendium of the activities MET values by the intensities, the values are taken from
+// the Compendium of Physical Activities (Ainsworth et al., 2011).
+var activityMETs = map[string]map[string]float64{
+	common.ActivityTypeWalking: {
+		common.ActivityIntensityLight:    2.8,
+		common.ActivityIntensityModerate: 3.5,
+		common.ActivityIntensityVigorous: 5.0,
+	},
+	common.ActivityTypeRunning: {
+		common.ActivityIntensityLight:    6.0,
+		common.ActivityIntensityModerate: 8.3,
+		common.ActivityIntensityVigorous: 11.0,
+	},
+	common.ActivityTypeCycling: {
+		common.ActivityIntensityLight:    4.0,
+		common.ActivityIntensityModerate: 6.8,
+		common.ActivityIntensityVigorous: 10.0,
+	},
+	common.ActivityTypeSwimming: {
+		common.ActivityIntensityLight:    6.0,
+		common.ActivityIntensityModerate: 8.3,
+		common.ActivityIntensityVigorous: 9.8,
+	},
+	common.ActivityTypeSkiing: {
+		common.ActivityIntensityLight:    6.8,
+		common.ActivityIntensityModerate: 9.0,
+		common.ActivityIntensityVigorous: 12.5,
+	},
+	common.ActivityTypeDancing: {
+		common.ActivityIntensityLight:    3.0,
+		common.ActivityIntensityModerate: 5.0,
+		common.ActivityIntensityVigorous: 7.3,
+	},
+	common.ActivityTypeTeamSports: {
+		common.ActivityIntensityLight:    4.0,
+		common.ActivityIntensityModerate: 7.0,
+		common.ActivityIntensityVigorous: 10.0,
+	},
+	common.ActivityTypeStrengthTraining: {
+		common.ActivityIntensityLight:    3.5,
+		common.ActivityIntensityModerate: 5.0,
+		common.ActivityIntensityVigorous: 6.0,
+	},
+	common.ActivityTypeYoga: {
+		common.ActivityIntensityLight:    2.3,
+		common.ActivityIntensityModerate: 2.5,
+		common.ActivityIntensityVigorous: 4.0,
+	},
+	common.ActivityTypeGardening: {
+		common.ActivityIntensityLight:    2.3,
+		common.ActivityIntensityModerate: 3.8,
+		common.ActivityIntensityVigorous: 5.0,
+	},
+	common.ActivityTypeHousework: {
+		common.ActivityIntensityLight:    2.3,
+		common.ActivityIntensityModerate: 3.3,
+		common.ActivityIntensityVigorous: 3.8,
+	},
+}
+
+// activityTypesOrder is the order of the activities in the compendium
+var activityTypesOrder = []string{
+	common.ActivityTypeWalking,
+	common.ActivityTypeRunning,
+	common.ActivityTypeCycling,
+	common.ActivityTypeSwimming,
+	common.ActivityTypeSkiing,
+	common.ActivityTypeDancing,
+	common.ActivityTypeTeamSports,
+	common.ActivityTypeStrengthTraining,
+	common.ActivityTypeYoga,
+	common.ActivityTypeGardening,
+	common.ActivityTypeHousework,
+}
+
+// activityTypeNames are the names of the activities.
+var activityTypeNames = map[string]string{
+	common.ActivityTypeWalking:          "Ходьба",
+	common.ActivityTypeRunning:          "Бег",
+	common.ActivityTypeCycling:          "Езда на велосипеде",
+	common.ActivityTypeSwimming:         "Плавание",
+	common.ActivityTypeSkiing:           "Лыжи",
+	common.ActivityTypeDancing:          "Танцы, аэробика",
+	common.ActivityTypeTeamSports:       "Командные игры (футбол, волейбол, баскетбол)",
+	common.ActivityTypeStrengthTraining: "Силовые упражнения",
+	common.ActivityTypeYoga:             "Йога, растяжка",
+	common.ActivityTypeGardening:        "Работа в саду",
+	common.ActivityTypeHousework:        "Работа по дому",
+}
+
+// ActivityType represents the compendium activity with its MET values by the intensities.
+type ActivityType struct {
+	Type string             `json:"type"`
+	Name string             `json:"name"`
+	METs map[string]float64 `json:"mets"`
+}
+
+// ActivityCompendium returns the built-in compendium of the activities the log entries may be recorded for.
+func ActivityCompendium() []*ActivityType {
+	compendium := make([]*ActivityType, 0, len(activityTypesOrder))
+	for _, activityType := range activityTypesOrder {
+		compendium = append(compendium, &ActivityType{
+			Type: activityType,
+			Name: activityTypeNames[activityType],
+			METs: activityMETs[activityType],
+		})
+	}
+	return compendium
+}
+
+// ActivityEntry represents the single physical activity log entry.
+type ActivityEntry struct {
+	ID        uint64 `json:"id,omitempty" db:"id"`
+	UserID    uint64 `json:"-" db:"user_id"`
+	Type      string `json:"type" db:"type"`
+	Intensity string `json:"intensity" db:"intensity"`
+	// Duration is in minutes
+	Duration int `json:"duration" db:"duration"`
+	// MET is the metabolic equivalent of the activity taken from the compendium on the entry creation, see Evaluate
+	MET        float64 `json:"met" db:"met"`
+	METMinutes float64 `json:"metMinutes" db:"-"`
+	// PerformedAt is optional, the current time is assumed if not set
+	PerformedAt model.Datetime `json:"performedAt" db:"performed_at"`
+	CreatedAt   model.Datetime `json:"createdAt" db:"created_at"`
+}
+
+func (e ActivityEntry) Validate() error {
+	err := validation.ValidateStruct(&e,
+		validation.Field(&e.Type, validation.Required, validation.In(
+			common.ActivityTypeWalking,
+			common.ActivityTypeRunning,
+			common.ActivityTypeCycling,
+			common.ActivityTypeSwimming,
+			common.ActivityTypeSkiing,
+			common.ActivityTypeDancing,
+			common.ActivityTypeTeamSports,
+			common.ActivityTypeStrengthTraining,
+			common.ActivityTypeYoga,
+			common.ActivityTypeGardening,
+			common.ActivityTypeHousework,
+		)),
+		validation.Field(&e.Intensity, validation.Required, validation.In(
+			common.ActivityIntensityLight, common.ActivityIntensityModerate, common.ActivityIntensityVigorous,
+		)),
+		validation.Field(&e.Duration, validation.Required, validation.Min(1), validation.Max(24*60)),
+	)
+	if err != nil {
+		var errBytes []byte
+		errBytes, err = json.Marshal(err)
+		if err != nil {
+			return err
+		}
+
+		var validationErrors map[string]string
+		if err = json.Unmarshal(errBytes, &validationErrors); err != nil {
+			return err
+		}
+
+		if validationError, found := validationErrors["type"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidActivityType, validationError)
+		}
+		if validationError, found := validationErrors["intensity"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidActivityIntensity, validationError)
+		}
+		if validationError, found := validationErrors["duration"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidActivityDuration, validationError)
+		}
+
+		return ErrInvalidActivityData
+	}
+
+	return nil
+}
+
+// Evaluate sets the MET value of the entry from the compendium if it is not set yet and calculates the MET-minutes.
+// The MET value is kept with the entry, so the compendium changes do not affect the recorded entries.
+func (e *ActivityEntry) Evaluate() {
+	if e.MET == 0 {
+		e.MET = activityMETs[e.Type][e.Intensity]
+	}
+	e.METMinutes = roundTo(e.MET*float64(e.Duration), 1)
+}
+
+// ActivityLogCriteria represents the criteria of the user physical activity log listing.
+type ActivityLogCriteria struct {
+	UserID uint64
+	// activity dates range, the bounds are optional and inclusive
+	From time.Time
+	To   time.Time
+}
+
+func (c ActivityLogCriteria) Validate() error {
+	if !c.From.IsZero() && !c.To.IsZero() && c.From.After(c.To) {
+		return fmt.Errorf("%w: from must be no later than to", ErrInvalidActivityLogCriteria)
+	}
+	return nil
+}
+
+// WholeWeeks returns the criteria with the dates range extended to the whole calendar weeks, so the weekly progress
+// of the boundary weeks is calculated by all their entries.
+func (c ActivityLogCriteria) WholeWeeks() ActivityLogCriteria {
+	if !c.From.IsZero() {
+		c.From = startOfWeek(c.From)
+	}
+	if !c.To.IsZero() {
+		c.To = startOfWeek(c.To).AddDate(0, 0, 6)
+	}
+	return c
+}
+
+// ActivitySummary represents the physical activity totals compared with the WHO target. The minutes are counted by
+// the MET values of the entries rather than the declared intensities: the activities of less than 3 METs are light,
+// of 6 METs and more are vigorous.
+type ActivitySummary struct {
+	METMinutes      float64 `json:"metMinutes"`
+	LightMinutes    int     `json:"lightMinutes"`
+	ModerateMinutes int     `json:"moderateMinutes"`
+	VigorousMinutes int     `json:"vigorousMinutes"`
+	// ModerateEquivalentMinutes is the moderate minutes plus the doubled vigorous minutes, it is compared with the
+	// WHO target
+	ModerateEquivalentMinutes int    `json:"moderateEquivalentMinutes"`
+	TargetStatus              string `json:"targetStatus"`
+	// TargetRemainingMinutes is the number of the moderate-equivalent minutes left to the target minimum
+	TargetRemainingMinutes int `json:"targetRemainingMinutes"`
+	EntriesCount           int `json:"entriesCount"`
+}
+
+// SummarizeActivity sums up the entries performed in the [from, to) time range.
+func SummarizeActivity(entries []*ActivityEntry, from, to time.Time) *ActivitySummary {
+	summary := &ActivitySummary{}
+
+	var metMinutes float64
+	for _, entry := range entries {
+		if entry.PerformedAt.Before(from) || !entry.PerformedAt.Before(to) {
+			continue
+		}
+
+		met := entry.MET
+		if met == 0 {
+			met = activityMETs[entry.Type][entry.Intensity]
+		}
+		metMinutes += met * float64(entry.Duration)
+
+		switch {
+		case met >= vigorousActivityMinMET:
+			summary.VigorousMinutes += entry.Duration
+		case met >= moderateActivityMinMET:
+			summary.ModerateMinutes += entry.Duration
+		default:
+			summary.LightMinutes += entry.Duration
+		}
+		summary.EntriesCount++
+	}
+
+	summary.METMinutes = roundTo(metMinutes, 1)
+	summary.ModerateEquivalentMinutes = summary.ModerateMinutes + 2*summary.VigorousMinutes
+
+	switch {
+	case summary.ModerateEquivalentMinutes < ActivityTargetMinMinutes:
+		summary.TargetStatus = common.ActivityTargetBelow
+		summary.TargetRemainingMinutes = ActivityTargetMinMinutes - summary.ModerateEquivalentMinutes
+	case summary.ModerateEquivalentMinutes <= ActivityTargetMaxMinutes:
+		summary.TargetStatus = common.ActivityTargetWithin
+	default:
+		summary.TargetStatus = common.ActivityTargetAbove
+	}
+
+	return summary
+}
+
+// SummarizeRecentActivity sums up the entries of the last 7 days.
+func SummarizeRecentActivity(entries []*ActivityEntry, now time.Time) *ActivitySummary {
+	return SummarizeActivity(entries, now.AddDate(0, 0, -7), now)
+}
+
+// ActivityWeeklyProgress represents the physical activity of the calendar week (starting on Monday).
+type ActivityWeeklyProgress struct {
+	WeekStart model.Date `json:"weekStart"`
+	*ActivitySummary
+}
+
+// ActivityWeeklyProgresses returns the weekly physical activity progress of the entries sorted from the newest to the
+// oldest week, the weeks without entries are omitted.
+func ActivityWeeklyProgresses(entries []*ActivityEntry) []*ActivityWeeklyProgress {
+	weekStarts := make([]time.Time, 0)
+	seen := make(map[time.Time]bool)
+	for _, entry := range entries {
+		weekStart := startOfWeek(entry.PerformedAt.Time)
+		if !seen[weekStart] {
+			seen[weekStart] = true
+			weekStarts = append(weekStarts, weekStart)
+		}
+	}
+	sort.Slice(weekStarts, func(i, j int) bool {
+		return weekStarts[i].After(weekStarts[j])
+	})
+
+	progresses := make([]*ActivityWeeklyProgress, 0, len(weekStarts))
+	for _, weekStart := range weekStarts {
+		progresses = append(progresses, &ActivityWeeklyProgress{
+			WeekStart:       model.Date{Time: weekStart},
+			ActivitySummary: SummarizeActivity(entries, weekStart, weekStart.AddDate(0, 0, 7)),
+		})
+	}
+
+	return progresses
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
 	"github.com/cardio-analyst/backend/internal/pkg/model"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
@@ -21,6 +22,8 @@ var (
 	ErrAnalysisRecordNotFound                 = errors.New("analysis record with this id not found")
 )
 
+// Analysis represents the lab results of the same collection with the fixed set of values, it is the compatibility view
+// of the lab results (see LabResult).
 type Analysis struct {
 	ID                              uint64         `json:"id,omitempty" db:"id"`
 	UserID                          uint64         `json:"-" db:"user_id"`
@@ -33,9 +36,72 @@ type Analysis struct {
 	Creatinine                      *float64       `json:"creatinine" db:"creatinine"`
 	AtheroscleroticPlaquesPresence  *bool          `json:"atheroscleroticPlaquesPresence" db:"atherosclerotic_plaques_presence"`
 	CreatedAt                       model.Datetime `json:"createdAt" db:"created_at"`
+	// calculated while reading, see Derive
+	EstimatedGlomerularFiltrationRate *float64 `json:"estimatedGlomerularFiltrationRate,omitempty" db:"-"`
+	ComputedValues                    []string `json:"computedValues,omitempty" db:"-"`
+	// reference statuses of the values, the keys are the values json names
+	Flags map[string]string `json:"flags,omitempty" db:"-"`
+	// units of the values, SI units are assumed for the values which are not listed
+	Units Units `json:"units,omitempty" db:"-"`
 }
 
-func (a Analysis) Validate(updating bool) error {
+// LabTestValues returns the pointers to the analysis values stored as the lab results by the lab tests codes.
+func (a *Analysis) LabTestValues() map[string]**float64 {
+	return map[string]**float64{
+		common.AnalysisValueHighDensityCholesterol:          &a.HighDensityCholesterol,
+		common.AnalysisValueLowDensityCholesterol:           &a.LowDensityCholesterol,
+		common.AnalysisValueTriglycerides:                   &a.Triglycerides,
+		common.AnalysisValueLipoprotein:                     &a.Lipoprotein,
+		common.AnalysisValueHighlySensitiveCReactiveProtein: &a.HighlySensitiveCReactiveProtein,
+		common.AnalysisValueAtherogenicityCoefficient:       &a.AtherogenicityCoefficient,
+		common.AnalysisValueCreatinine:                      &a.Creatinine,
+	}
+}
+
+// Values returns the numeric values of the analysis by their json names, the values which are not set are skipped.
+func (a *Analysis) Values() map[string]float64 {
+	values := make(map[string]float64)
+	for valueName, value := range a.measuredValues() {
+		if *value.value != nil {
+			values[valueName] = **value.value
+		}
+	}
+	if a.AtherogenicityCoefficient != nil {
+		values[common.AnalysisValueAtherogenicityCoefficient] = *a.AtherogenicityCoefficient
+	}
+	if a.EstimatedGlomerularFiltrationRate != nil {
+		values[common.AnalysisValueEstimatedGlomerularFiltrationRate] = *a.EstimatedGlomerularFiltrationRate
+	}
+	return values
+}
+
+func (a *Analysis) measuredValues() map[string]measuredValue {
+	return map[string]measuredValue{
+		common.AnalysisValueHighDensityCholesterol:          {value: &a.HighDensityCholesterol, measurement: measurementCholesterol},
+		common.AnalysisValueLowDensityCholesterol:           {value: &a.LowDensityCholesterol, measurement: measurementCholesterol},
+		common.AnalysisValueTriglycerides:                   {value: &a.Triglycerides, measurement: measurementTriglycerides},
+		common.AnalysisValueLipoprotein:                     {value: &a.Lipoprotein, measurement: measurementLipoprotein},
+		common.AnalysisValueHighlySensitiveCReactiveProtein: {value: &a.HighlySensitiveCReactiveProtein, measurement: measurementCReactiveProtein},
+		common.AnalysisValueCreatinine:                      {value: &a.Creatinine, measurement: measurementCreatinine},
+	}
+}
+
+// NormalizeUnits converts the values declared in the conventional units to the SI ones.
+func (a *Analysis) NormalizeUnits() error {
+	if err := normalizeUnits(a.Units, a.measuredValues()); err != nil {
+		return err
+	}
+	a.Units = nil
+	return nil
+}
+
+// ConvertUnits converts the SI values to the unit system and declares the units of the values.
+func (a *Analysis) ConvertUnits(unitSystem string) {
+	a.Units = convertUnits(unitSystem, a.measuredValues())
+}
+
+// Validate validates the analysis values according to the lab tests catalog validation ranges.
+func (a Analysis) Validate(updating bool, labTests map[string]*LabTest) error {
 	err := validation.ValidateStruct(&a,
 		validation.Field(&a.ID, validation.When(
 			updating,
@@ -44,31 +110,31 @@ func (a Analysis) Validate(updating bool) error {
 		validation.Field(&a.UserID, validation.Required),
 		validation.Field(&a.HighDensityCholesterol, validation.When(
 			a.HighDensityCholesterol != nil,
-			validation.Required, validation.Min(0.5), validation.Max(5.5),
+			append([]validation.Rule{validation.Required}, labTests[common.AnalysisValueHighDensityCholesterol].rules()...)...,
 		)),
 		validation.Field(&a.LowDensityCholesterol, validation.When(
 			a.LowDensityCholesterol != nil,
-			validation.Required, validation.Min(0.5), validation.Max(8.5),
+			append([]validation.Rule{validation.Required}, labTests[common.AnalysisValueLowDensityCholesterol].rules()...)...,
 		)),
 		validation.Field(&a.Triglycerides, validation.When(
 			a.Triglycerides != nil,
-			validation.Required, validation.Min(0.2), validation.Max(8.5),
+			append([]validation.Rule{validation.Required}, labTests[common.AnalysisValueTriglycerides].rules()...)...,
 		)),
 		validation.Field(&a.Lipoprotein, validation.When(
 			a.Lipoprotein != nil,
-			validation.Min(0.0), validation.Max(10.0),
+			labTests[common.AnalysisValueLipoprotein].rules()...,
 		)),
 		validation.Field(&a.HighlySensitiveCReactiveProtein, validation.When(
 			a.HighlySensitiveCReactiveProtein != nil,
-			validation.Required, validation.Min(0.1), validation.Max(12.0),
+			append([]validation.Rule{validation.Required}, labTests[common.AnalysisValueHighlySensitiveCReactiveProtein].rules()...)...,
 		)),
 		validation.Field(&a.AtherogenicityCoefficient, validation.When(
 			a.AtherogenicityCoefficient != nil,
-			validation.Required, validation.Min(0.1), validation.Max(8.0),
+			append([]validation.Rule{validation.Required}, labTests[common.AnalysisValueAtherogenicityCoefficient].rules()...)...,
 		)),
 		validation.Field(&a.Creatinine, validation.When(
 			a.Creatinine != nil,
-			validation.Required, validation.Min(20.0), validation.Max(500.0),
+			append([]validation.Rule{validation.Required}, labTests[common.AnalysisValueCreatinine].rules()...)...,
 		)),
 	)
 	if err != nil {
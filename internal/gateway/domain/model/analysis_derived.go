@@ -0,0 +1,138 @@
+package model
+
+import (
+	"math"
+	"time"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+// creatinineMicromolesPerMilligram converts creatinine from µmol/L to mg/dL
+const creatinineMicromolesPerMilligram = 88.4
+
+// friedewaldMaxTriglycerides is the triglycerides level (mmol/L) above which the Friedewald formula is not applicable.
+// LDL cholesterol is not estimated above it. The Martin-Hopkins equation is not implemented: it is validated up to the
+// same level, its extension to the higher triglycerides requires the separate factors table and is still inaccurate,
+// so the ESC 2019 guidelines advise the direct LDL cholesterol measurement in this case.
+const friedewaldMaxTriglycerides = 4.5
+
+// derivationCholesterolMaxDays is the maximal number of days between the analysis collection and the total cholesterol
+// measurement the analysis values are derived by, the measurement is not considered to be contemporaneous otherwise.
+const derivationCholesterolMaxDays = 30
+
+// AnalysisDerivationData represents the user data required to derive the analysis values, zero values are unknown.
+type AnalysisDerivationData struct {
+	Age                   int
+	Gender                string
+	TotalCholesterolLevel float64
+}
+
+// DeriveAnalyses derives the values of the analyses (see Derive) by the data in effect at their collection: the age of
+// the user at the collection date and the basic indicators total cholesterol measured closest to it. The values
+// depending on the total cholesterol are not derived if it is not measured within derivationCholesterolMaxDays of the
+// collection.
+func DeriveAnalyses(analyses []*Analysis, basicIndicators []*BasicIndicators, gender string, user model.User) {
+	for _, analysis := range analyses {
+		analysis.Derive(AnalysisDerivationData{
+			Age:                   user.AgeAt(analysis.CreatedAt.Time),
+			Gender:                gender,
+			TotalCholesterolLevel: contemporaneousTotalCholesterolLevel(basicIndicators, analysis.CreatedAt.Time),
+		})
+	}
+}
+
+// contemporaneousTotalCholesterolLevel returns the total cholesterol measured closest to the moment within
+// derivationCholesterolMaxDays, 0 if there is no such measurement.
+func contemporaneousTotalCholesterolLevel(basicIndicators []*BasicIndicators, moment time.Time) float64 {
+	var (
+		level    float64
+		distance time.Duration
+	)
+	maxDistance := time.Duration(derivationCholesterolMaxDays) * 24 * time.Hour
+	for _, indicators := range basicIndicators {
+		if indicators.TotalCholesterolLevel == nil {
+			continue
+		}
+
+		indicatorsDistance := indicators.CreatedAt.Sub(moment)
+		if indicatorsDistance < 0 {
+			indicatorsDistance = -indicatorsDistance
+		}
+		if indicatorsDistance > maxDistance || (level > 0 && indicatorsDistance >= distance) {
+			continue
+		}
+
+		level, distance = *indicators.TotalCholesterolLevel, indicatorsDistance
+	}
+	return level
+}
+
+// Derive calculates the values which are not entered by the user but can be derived from the other ones and marks
+// them as computed. The values entered by the user are never overwritten:
+//   - eGFR is calculated by the CKD-EPI 2021 (race-free) creatinine equation;
+//   - LDL cholesterol is estimated by the Friedewald formula up to friedewaldMaxTriglycerides;
+//   - atherogenicity coefficient is calculated as (total cholesterol - HDL cholesterol) / HDL cholesterol.
+func (a *Analysis) Derive(data AnalysisDerivationData) {
+	if a.Creatinine != nil && data.Age > 0 {
+		if eGFR, ok := ckdEPI2021(*a.Creatinine, data.Age, data.Gender); ok {
+			a.EstimatedGlomerularFiltrationRate = &eGFR
+			a.ComputedValues = append(a.ComputedValues, common.AnalysisValueEstimatedGlomerularFiltrationRate)
+		}
+	}
+
+	if data.TotalCholesterolLevel == 0 || a.HighDensityCholesterol == nil || *a.HighDensityCholesterol >= data.TotalCholesterolLevel {
+		return
+	}
+	hdlCholesterol := *a.HighDensityCholesterol
+
+	if a.LowDensityCholesterol == nil && a.Triglycerides != nil && *a.Triglycerides <= friedewaldMaxTriglycerides {
+		ldlCholesterol := roundTo(data.TotalCholesterolLevel-hdlCholesterol-*a.Triglycerides/2.2, 1)
+		if ldlCholesterol > 0 {
+			a.LowDensityCholesterol = &ldlCholesterol
+			a.ComputedValues = append(a.ComputedValues, common.AnalysisValueLowDensityCholesterol)
+		}
+	}
+
+	if a.AtherogenicityCoefficient == nil {
+		atherogenicityCoefficient := roundTo((data.TotalCholesterolLevel-hdlCholesterol)/hdlCholesterol, 1)
+		a.AtherogenicityCoefficient = &atherogenicityCoefficient
+		a.ComputedValues = append(a.ComputedValues, common.AnalysisValueAtherogenicityCoefficient)
+	}
+}
+
+// IsComputed reports whether the value (designated by its json name) is derived from the other ones.
+func (a *Analysis) IsComputed(value string) bool {
+	for _, computedValue := range a.ComputedValues {
+		if computedValue == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ckdEPI2021 calculates eGFR (mL/min/1.73 m²) from creatinine (µmol/L), see Inker LA et al., N Engl J Med
+// 2021;385:1737-49.
+func ckdEPI2021(creatinine float64, age int, gender string) (float64, bool) {
+	var kappa, alpha, sexFactor float64
+	switch gender {
+	case common.UserGenderFemale:
+		kappa, alpha, sexFactor = 0.7, -0.241, 1.012
+	case common.UserGenderMale:
+		kappa, alpha, sexFactor = 0.9, -0.302, 1
+	default:
+		return 0, false
+	}
+
+	ratio := creatinine / creatinineMicromolesPerMilligram / kappa
+
+	eGFR := 142 * math.Pow(math.Min(ratio, 1), alpha) * math.Pow(math.Max(ratio, 1), -1.2) *
+		math.Pow(0.9938, float64(age)) * sexFactor
+
+	return roundTo(eGFR, 0), true
+}
+
+func roundTo(value float64, precision int) float64 {
+	ratio := math.Pow(10, float64(precision))
+	return math.Round(value*ratio) / ratio
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+)
+
+func TestAnalysisDeriveLowDensityCholesterol(t *testing.T) {
+	testCases := []struct {
+		name           string
+		triglycerides  float64
+		ldlCholesterol *float64
+		want           *float64
+		computed       bool
+	}{
+		{
+			name:          "below the Friedewald cut-off",
+			triglycerides: 2.2,
+			want:          float64Pointer(3.3),
+			computed:      true,
+		},
+		{
+			name:          "at the Friedewald cut-off",
+			triglycerides: friedewaldMaxTriglycerides,
+			want:          float64Pointer(2.3),
+			computed:      true,
+		},
+		{
+			name:          "above the Friedewald cut-off",
+			triglycerides: 4.6,
+		},
+		{
+			name:           "entered",
+			triglycerides:  2.2,
+			ldlCholesterol: float64Pointer(3.5),
+			want:           float64Pointer(3.5),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			analysis := &Analysis{
+				HighDensityCholesterol: float64Pointer(1.2),
+				Triglycerides:          float64Pointer(testCase.triglycerides),
+				LowDensityCholesterol:  testCase.ldlCholesterol,
+			}
+			analysis.Derive(AnalysisDerivationData{TotalCholesterolLevel: 5.5})
+
+			switch {
+			case testCase.want == nil && analysis.LowDensityCholesterol != nil:
+				t.Errorf("got LDL cholesterol %v, want it unknown", *analysis.LowDensityCholesterol)
+			case testCase.want != nil && analysis.LowDensityCholesterol == nil:
+				t.Errorf("got LDL cholesterol unknown, want %v", *testCase.want)
+			case testCase.want != nil && *analysis.LowDensityCholesterol != *testCase.want:
+				t.Errorf("got LDL cholesterol %v, want %v", *analysis.LowDensityCholesterol, *testCase.want)
+			}
+
+			if computed := analysis.IsComputed(common.AnalysisValueLowDensityCholesterol); computed != testCase.computed {
+				t.Errorf("LDL cholesterol computed: %v, want %v", computed, testCase.computed)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+var ErrInvalidAnginaRoseAnswers = errors.New("invalid angina rose answers")
+
+// AnginaRoseAnswers represents the answers to the Rose angina questionnaire (WHO). The questions after the first one
+// are asked only if the user has chest pain, the questions about the pain while walking are asked only if the pain
+// occurs on exertion. The severe prolonged pain question is always asked.
+type AnginaRoseAnswers struct {
+	// Q1: have you ever had any pain or discomfort in your chest?
+	ChestPain *bool `json:"chestPain"`
+	// Q2: do you get it when you walk uphill or hurry?
+	PainUphillOrHurry string `json:"painUphillOrHurry"`
+	// Q3: do you get it when you walk at an ordinary pace on the level?
+	PainOrdinaryPace *bool `json:"painOrdinaryPace"`
+	// Q4: what do you do if you get it while you are walking?
+	ActionWhileWalking string `json:"actionWhileWalking"`
+	// Q5: if you stand still, is it relieved?
+	ReliefOnStanding *bool `json:"reliefOnStanding"`
+	// Q6: is it relieved in 10 minutes or less?
+	ReliefWithinTenMinutes *bool `json:"reliefWithinTenMinutes"`
+	// Q7: where do you get this pain?
+	PainSites []string `json:"painSites"`
+	// Q8: have you ever had a severe pain across the front of your chest lasting for half an hour or more?
+	SevereProlongedPain *bool `json:"severeProlongedPain"`
+}
+
+func (a AnginaRoseAnswers) Validate() error {
+	chestPain := a.ChestPain != nil && *a.ChestPain
+	exertionalPain := chestPain && a.exertionalPain()
+	reliefOnStanding := exertionalPain && a.ReliefOnStanding != nil && *a.ReliefOnStanding
+
+	err := validation.ValidateStruct(&a,
+		validation.Field(&a.ChestPain, validation.NotNil),
+		validation.Field(&a.PainUphillOrHurry,
+			validation.When(chestPain, validation.Required).Else(validation.Empty),
+			validation.In(common.AnginaRoseAnswerYes, common.AnginaRoseAnswerNo, common.AnginaRoseAnswerNever),
+		),
+		validation.Field(&a.PainOrdinaryPace, validation.When(chestPain, validation.NotNil).Else(validation.Nil)),
+		validation.Field(&a.ActionWhileWalking,
+			validation.When(exertionalPain, validation.Required).Else(validation.Empty),
+			validation.In(common.AnginaRoseActionStop, common.AnginaRoseActionCarryOn),
+		),
+		validation.Field(&a.ReliefOnStanding, validation.When(exertionalPain, validation.NotNil).Else(validation.Nil)),
+		validation.Field(&a.ReliefWithinTenMinutes,
+			validation.When(reliefOnStanding, validation.NotNil).Else(validation.Nil),
+		),
+		validation.Field(&a.PainSites,
+			validation.When(chestPain, validation.Required).Else(validation.Empty),
+			validation.Each(validation.In(
+				common.AnginaRosePainSiteSternumUpper,
+				common.AnginaRosePainSiteSternumLower,
+				common.AnginaRosePainSiteLeftAnteriorChest,
+				common.AnginaRosePainSiteLeftArm,
+				common.AnginaRosePainSiteOther,
+			)),
+		),
+		validation.Field(&a.SevereProlongedPain, validation.NotNil),
+	)
+	if err != nil {
+		var errBytes []byte
+		errBytes, err = json.Marshal(err)
+		if err != nil {
+			return err
+		}
+
+		// the pain sites errors are the nested objects keyed by the index
+		var validationErrors map[string]interface{}
+		if err = json.Unmarshal(errBytes, &validationErrors); err != nil {
+			return err
+		}
+
+		// the answers are reported in the order of the questions
+		for _, field := range []string{
+			"chestPain",
+			"painUphillOrHurry",
+			"painOrdinaryPace",
+			"actionWhileWalking",
+			"reliefOnStanding",
+			"reliefWithinTenMinutes",
+			"painSites",
+			"severeProlongedPain",
+		} {
+			if validationError, found := validationErrors[field]; found {
+				return fmt.Errorf("%w: %v: %v", ErrInvalidAnginaRoseAnswers, field, validationError)
+			}
+		}
+
+		return ErrInvalidAnginaRoseAnswers
+	}
+
+	return nil
+}
+
+// exertionalPain returns true if the pain occurs when walking uphill, hurrying or walking at an ordinary pace.
+func (a AnginaRoseAnswers) exertionalPain() bool {
+	return a.PainUphillOrHurry == common.AnginaRoseAnswerYes || (a.PainOrdinaryPace != nil && *a.PainOrdinaryPace)
+}
+
+// typicalPainSite returns true if the pain is located in the sternum or in both the left anterior chest and the left
+// arm.
+func (a AnginaRoseAnswers) typicalPainSite() bool {
+	sites := make(map[string]bool, len(a.PainSites))
+	for _, site := range a.PainSites {
+		sites[site] = true
+	}
+
+	return sites[common.AnginaRosePainSiteSternumUpper] || sites[common.AnginaRosePainSiteSternumLower] ||
+		(sites[common.AnginaRosePainSiteLeftAnteriorChest] && sites[common.AnginaRosePainSiteLeftArm])
+}
+
+// AnginaRoseResult represents the classification of the Rose angina questionnaire answers.
+type AnginaRoseResult struct {
+	ID             uint64            `json:"id,omitempty"`
+	UserID         uint64            `json:"-"`
+	Answers        AnginaRoseAnswers `json:"answers"`
+	Classification string            `json:"classification"`
+	// Grade is the grade of the definite angina: 1 if the pain occurs when walking uphill or hurrying only, 2 if it
+	// occurs when walking at an ordinary pace as well; 0 for the other classifications
+	Grade int `json:"grade"`
+	// PossibleInfarction is true if the user had a severe chest pain lasting for half an hour or more
+	PossibleInfarction bool           `json:"possibleInfarction"`
+	CreatedAt          model.Datetime `json:"createdAt"`
+}
+
+// ClassifyAnginaRose classifies the validated answers. The angina is definite if the exertional pain makes the user
+// stop or slow down, is relieved within 10 minutes of standing still and is located typically, the other exertional
+// pain is classified as the possible angina.
+func ClassifyAnginaRose(answers AnginaRoseAnswers) AnginaRoseResult {
+	result := AnginaRoseResult{
+		Answers:            answers,
+		Classification:     common.AnginaRoseClassificationNone,
+		PossibleInfarction: answers.SevereProlongedPain != nil && *answers.SevereProlongedPain,
+	}
+
+	if answers.ChestPain == nil || !*answers.ChestPain || !answers.exertionalPain() {
+		return result
+	}
+
+	definite := answers.ActionWhileWalking == common.AnginaRoseActionStop &&
+		answers.ReliefOnStanding != nil && *answers.ReliefOnStanding &&
+		answers.ReliefWithinTenMinutes != nil && *answers.ReliefWithinTenMinutes &&
+		answers.typicalPainSite()
+	if !definite {
+		result.Classification = common.AnginaRoseClassificationPossible
+		return result
+	}
+
+	result.Classification = common.AnginaRoseClassificationDefinite
+	result.Grade = 1
+	if answers.PainOrdinaryPace != nil && *answers.PainOrdinaryPace {
+		result.Grade = 2
+	}
+
+	return result
+}
+
+// Score returns the result as the questionnaire angina score: 0 - no angina, 1 - possible angina, 2 - definite angina
+// of grade 1, 3 - definite angina of grade 2.
+func (r AnginaRoseResult) Score() int8 {
+	switch r.Classification {
+	case common.AnginaRoseClassificationPossible:
+		return 1
+	case common.AnginaRoseClassificationDefinite:
+		return int8(1 + r.Grade)
+	default:
+		return 0
+	}
+}
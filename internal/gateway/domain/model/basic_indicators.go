@@ -35,10 +35,37 @@ type BasicIndicators struct {
 	SBPLevel                     *float64       `json:"sbpLevel" db:"sbp_level"`
 	Smoking                      *bool          `json:"smoking" db:"smoking"`
 	TotalCholesterolLevel        *float64       `json:"totalCholesterolLevel" db:"total_cholesterol_level"`
-	CVEventsRiskValue            *int64         `json:"cvEventsRiskValue" db:"cv_events_risk_value"`
+	CVEventsRiskValue            *float64       `json:"cvEventsRiskValue" db:"cv_events_risk_value"`
 	IdealCardiovascularAgesRange *string        `json:"idealCardiovascularAgesRange" db:"ideal_cardiovascular_ages_range"`
+	RiskScope                    *string        `json:"riskScope" db:"risk_scope"`
+	HypertensionCategory         *string        `json:"hypertensionCategory" db:"hypertension_category"` // classified by SBP level, see ClassifyBloodPressure
 	Scale                        string         `json:"scale" db:"-"`
 	CreatedAt                    model.Datetime `json:"createdAt" db:"created_at"`
+	// units of the values, SI units are assumed for the values which are not listed
+	Units Units `json:"units,omitempty" db:"-"`
+}
+
+func (a *BasicIndicators) measuredValues() map[string]measuredValue {
+	return map[string]measuredValue{
+		"weight":                {value: &a.Weight, measurement: measurementWeight},
+		"height":                {value: &a.Height, measurement: measurementLength},
+		"waistSize":             {value: &a.WaistSize, measurement: measurementLength},
+		"totalCholesterolLevel": {value: &a.TotalCholesterolLevel, measurement: measurementCholesterol},
+	}
+}
+
+// NormalizeUnits converts the values declared in the conventional units to the SI ones.
+func (a *BasicIndicators) NormalizeUnits() error {
+	if err := normalizeUnits(a.Units, a.measuredValues()); err != nil {
+		return err
+	}
+	a.Units = nil
+	return nil
+}
+
+// ConvertUnits converts the SI values to the unit system and declares the units of the values.
+func (a *BasicIndicators) ConvertUnits(unitSystem string) {
+	a.Units = convertUnits(unitSystem, a.measuredValues())
 }
 
 func (a BasicIndicators) Validate(updating bool) error {
@@ -78,12 +105,16 @@ func (a BasicIndicators) Validate(updating bool) error {
 		)),
 		validation.Field(&a.CVEventsRiskValue, validation.When(
 			a.CVEventsRiskValue != nil,
-			validation.Min(0), validation.Max(100),
+			validation.Min(0.0), validation.Max(100.0),
 		)),
 		validation.Field(&a.IdealCardiovascularAgesRange, validation.When(
 			a.IdealCardiovascularAgesRange != nil,
 			validation.Required,
 		)),
+		validation.Field(&a.RiskScope, validation.When(
+			a.RiskScope != nil,
+			validation.Required, validation.In(common.RiskScopeLow, common.RiskScopeModerate, common.RiskScopeHigh, common.RiskScopeVeryHigh),
+		)),
 	)
 	if err != nil {
 		var errBytes []byte
@@ -124,8 +155,22 @@ func (a BasicIndicators) Validate(updating bool) error {
 		if validationError, found := validationErrors["idealCardiovascularAgesRange"]; found {
 			return fmt.Errorf("%w: %v", ErrInvalidIdealCardiovascularAgesRange, validationError)
 		}
+		if validationError, found := validationErrors["riskScope"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidRiskScope, validationError)
+		}
 
 		return ErrInvalidBasicIndicatorsData
 	}
 	return nil
 }
+
+// Classify sets the blood pressure category by the SBP level, the category is reset if the SBP level is not set.
+func (a *BasicIndicators) Classify() {
+	if a.SBPLevel == nil {
+		a.HypertensionCategory = nil
+		return
+	}
+
+	category := ClassifyBloodPressure(*a.SBPLevel, nil)
+	a.HypertensionCategory = &category
+}
@@ -0,0 +1,289 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+var (
+	ErrInvalidSystolicPressure           = errors.New("invalid systolic value")
+	ErrInvalidDiastolicPressure          = errors.New("invalid diastolic value")
+	ErrInvalidPulse                      = errors.New("invalid pulse value")
+	ErrInvalidArm                        = errors.New("invalid arm value")
+	ErrInvalidPosition                   = errors.New("invalid position value")
+	ErrInvalidTimeOfDay                  = errors.New("invalid timeOfDay value")
+	ErrInvalidBloodPressureData          = errors.New("invalid blood pressure data")
+	ErrBloodPressureMeasurementNotFound  = errors.New("blood pressure measurement with this id not found")
+	ErrInvalidBloodPressureDiaryCriteria = errors.New("invalid blood pressure diary criteria")
+)
+
+// MinHomeBloodPressureDays is the minimum number of the measurement days of the week (after the first day is
+// discarded) for the weekly home blood pressure average to be used for the risk assessment, see ESH guidelines.
+const MinHomeBloodPressureDays = 3
+
+// morningEndHour is the hour the evening starts from if the time of day of the measurement is not set.
+const morningEndHour = 12
+
+// BloodPressureMeasurement represents the single home blood pressure diary entry. The pressure is in mmHg, the pulse
+// is in beats per minute.
+type BloodPressureMeasurement struct {
+	ID        uint64 `json:"id,omitempty" db:"id"`
+	UserID    uint64 `json:"-" db:"user_id"`
+	Systolic  int    `json:"systolic" db:"systolic"`
+	Diastolic int    `json:"diastolic" db:"diastolic"`
+	Pulse     *int   `json:"pulse" db:"pulse"`           // optional
+	Arm       string `json:"arm" db:"arm"`               // optional
+	Position  string `json:"position" db:"position"`     // optional
+	TimeOfDay string `json:"timeOfDay" db:"time_of_day"` // optional, resolved from the measurement time if not set
+	// HypertensionCategory is classified by the pressure, see ClassifyBloodPressure
+	HypertensionCategory string `json:"hypertensionCategory" db:"hypertension_category"`
+	// MeasuredAt is optional, the current time is assumed if not set
+	MeasuredAt model.Datetime `json:"measuredAt" db:"measured_at"`
+	CreatedAt  model.Datetime `json:"createdAt" db:"created_at"`
+}
+
+func (m BloodPressureMeasurement) Validate() error {
+	err := validation.ValidateStruct(&m,
+		validation.Field(&m.Systolic, validation.Required, validation.Min(60), validation.Max(300)),
+		validation.Field(&m.Diastolic, validation.Required, validation.Min(30), validation.Max(200)),
+		validation.Field(&m.Pulse, validation.Min(30), validation.Max(250)),
+		validation.Field(&m.Arm, validation.In(common.BloodPressureArmLeft, common.BloodPressureArmRight)),
+		validation.Field(&m.Position, validation.In(
+			common.BloodPressurePositionSitting, common.BloodPressurePositionStanding, common.BloodPressurePositionLying,
+		)),
+		validation.Field(&m.TimeOfDay, validation.In(common.BloodPressureTimeOfDayMorning, common.BloodPressureTimeOfDayEvening)),
+	)
+	if err != nil {
+		var errBytes []byte
+		errBytes, err = json.Marshal(err)
+		if err != nil {
+			return err
+		}
+
+		var validationErrors map[string]string
+		if err = json.Unmarshal(errBytes, &validationErrors); err != nil {
+			return err
+		}
+
+		if validationError, found := validationErrors["systolic"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidSystolicPressure, validationError)
+		}
+		if validationError, found := validationErrors["diastolic"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidDiastolicPressure, validationError)
+		}
+		if validationError, found := validationErrors["pulse"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidPulse, validationError)
+		}
+		if validationError, found := validationErrors["arm"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidArm, validationError)
+		}
+		if validationError, found := validationErrors["position"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidPosition, validationError)
+		}
+		if validationError, found := validationErrors["timeOfDay"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidTimeOfDay, validationError)
+		}
+
+		return ErrInvalidBloodPressureData
+	}
+
+	if m.Diastolic >= m.Systolic {
+		return fmt.Errorf("%w: must be less than systolic", ErrInvalidDiastolicPressure)
+	}
+
+	return nil
+}
+
+// Classify sets the blood pressure category of the measurement.
+func (m *BloodPressureMeasurement) Classify() {
+	diastolic := float64(m.Diastolic)
+	m.HypertensionCategory = ClassifyBloodPressure(float64(m.Systolic), &diastolic)
+}
+
+// ResolveTimeOfDay sets the time of day according to the measurement time if it is not set.
+func (m *BloodPressureMeasurement) ResolveTimeOfDay() {
+	if m.TimeOfDay != "" {
+		return
+	}
+
+	if m.MeasuredAt.Hour() < morningEndHour {
+		m.TimeOfDay = common.BloodPressureTimeOfDayMorning
+	} else {
+		m.TimeOfDay = common.BloodPressureTimeOfDayEvening
+	}
+}
+
+// BloodPressureDiaryCriteria represents the criteria of the user blood pressure diary listing.
+type BloodPressureDiaryCriteria struct {
+	UserID uint64
+	// measurement dates range, the bounds are optional and inclusive
+	From time.Time
+	To   time.Time
+}
+
+func (c BloodPressureDiaryCriteria) Validate() error {
+	if !c.From.IsZero() && !c.To.IsZero() && c.From.After(c.To) {
+		return fmt.Errorf("%w: from must be no later than to", ErrInvalidBloodPressureDiaryCriteria)
+	}
+	return nil
+}
+
+// WholeSeries returns the criteria the measurements of the weekly averages are listed by: the windows are counted
+// from the start of the monitoring series, so the earlier measurements are needed, and the windows starting in the
+// dates range may end after it.
+func (c BloodPressureDiaryCriteria) WholeSeries() BloodPressureDiaryCriteria {
+	c.From = time.Time{}
+	if !c.To.IsZero() {
+		c.To = c.To.AddDate(0, 0, 6)
+	}
+	return c
+}
+
+// Covers returns true if the window of the weekly average intersects the dates range.
+func (c BloodPressureDiaryCriteria) Covers(average *BloodPressureWeeklyAverage) bool {
+	windowStart := average.WeekStart.Time
+	if !c.From.IsZero() && windowStart.AddDate(0, 0, 6).Before(startOfDay(c.From)) {
+		return false
+	}
+	if !c.To.IsZero() && windowStart.After(startOfDay(c.To)) {
+		return false
+	}
+	return true
+}
+
+// BloodPressureWeeklyAverage represents the home blood pressure averaged over the 7-day window of the monitoring
+// series according to ESH rules: the measurements of the first day of the series are discarded, the rest are
+// averaged.
+type BloodPressureWeeklyAverage struct {
+	// WeekStart is the first day of the window
+	WeekStart model.Date `json:"weekStart"`
+	Systolic  float64    `json:"systolic"`
+	Diastolic float64    `json:"diastolic"`
+	// Pulse is nil if there are no pulse values among the averaged measurements
+	Pulse *float64 `json:"pulse"`
+	// the number of the averaged measurements and of their days
+	MeasurementsCount int `json:"measurementsCount"`
+	DaysCount         int `json:"daysCount"`
+	// Sufficient is true if the average is measured for at least MinHomeBloodPressureDays days
+	Sufficient     bool           `json:"sufficient"`
+	LastMeasuredAt model.Datetime `json:"lastMeasuredAt"`
+}
+
+// BloodPressureWeeklyAverages returns the weekly home blood pressure averages of the measurements sorted from the
+// newest to the oldest window. The 7-day windows are counted from the first measurement day of the series, the series
+// ends if there are no measurements for the whole window and the next measurement starts the new one. The windows
+// with the only first day of the series are omitted since all their measurements are discarded.
+func BloodPressureWeeklyAverages(measurements []*BloodPressureMeasurement) []*BloodPressureWeeklyAverage {
+	sorted := make([]*BloodPressureMeasurement, len(measurements))
+	copy(sorted, measurements)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].MeasuredAt.Before(sorted[j].MeasuredAt.Time)
+	})
+
+	averages := make([]*BloodPressureWeeklyAverage, 0)
+	var seriesStart, windowStart time.Time
+	for start := 0; start < len(sorted); {
+		day := startOfDay(sorted[start].MeasuredAt.Time)
+		if windowStart.IsZero() || !day.Before(windowStart.AddDate(0, 0, 14)) {
+			seriesStart, windowStart = day, day
+		} else {
+			windowStart = windowStart.AddDate(0, 0, 7)
+		}
+		windowEnd := windowStart.AddDate(0, 0, 7)
+
+		end := start
+		for end < len(sorted) && sorted[end].MeasuredAt.Before(windowEnd) {
+			end++
+		}
+
+		if average := weeklyAverage(windowStart, seriesStart, sorted[start:end]); average != nil {
+			averages = append(averages, average)
+		}
+
+		start = end
+	}
+
+	// from the newest to the oldest window
+	for i, j := 0, len(averages)-1; i < j; i, j = i+1, j-1 {
+		averages[i], averages[j] = averages[j], averages[i]
+	}
+
+	return averages
+}
+
+// weeklyAverage averages the measurements of the window sorted by the measurement time, the measurements of the
+// first day of the series are discarded. If there are no other measurements, nil is returned.
+func weeklyAverage(windowStart, seriesStart time.Time, measurements []*BloodPressureMeasurement) *BloodPressureWeeklyAverage {
+	var (
+		systolicSum, diastolicSum, pulseSum float64
+		count, pulseCount                   int
+		days                                = make(map[time.Time]bool)
+		lastMeasuredAt                      time.Time
+	)
+	for _, measurement := range measurements {
+		day := startOfDay(measurement.MeasuredAt.Time)
+		if day.Equal(seriesStart) {
+			continue
+		}
+
+		systolicSum += float64(measurement.Systolic)
+		diastolicSum += float64(measurement.Diastolic)
+		if measurement.Pulse != nil {
+			pulseSum += float64(*measurement.Pulse)
+			pulseCount++
+		}
+		count++
+		days[day] = true
+		lastMeasuredAt = measurement.MeasuredAt.Time
+	}
+
+	if count == 0 {
+		return nil
+	}
+
+	average := &BloodPressureWeeklyAverage{
+		WeekStart:         model.Date{Time: windowStart},
+		Systolic:          roundTo(systolicSum/float64(count), 1),
+		Diastolic:         roundTo(diastolicSum/float64(count), 1),
+		MeasurementsCount: count,
+		DaysCount:         len(days),
+		Sufficient:        len(days) >= MinHomeBloodPressureDays,
+		LastMeasuredAt:    model.Datetime{Time: lastMeasuredAt},
+	}
+	if pulseCount > 0 {
+		pulse := roundTo(pulseSum/float64(pulseCount), 1)
+		average.Pulse = &pulse
+	}
+
+	return average
+}
+
+// LatestSufficientBloodPressureAverage returns the newest weekly average suitable for the risk assessment, the
+// averages are expected to be sorted from the newest to the oldest week. If there is no such average, nil is returned.
+func LatestSufficientBloodPressureAverage(averages []*BloodPressureWeeklyAverage) *BloodPressureWeeklyAverage {
+	for _, average := range averages {
+		if average.Sufficient {
+			return average
+		}
+	}
+	return nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// startOfWeek returns the start of the Monday of the week.
+func startOfWeek(t time.Time) time.Time {
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	return startOfDay(t).AddDate(0, 0, -daysSinceMonday)
+}
@@ -0,0 +1,109 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+// bloodPressureTestSeries returns the morning and evening measurements of the days counted from the start day.
+func bloodPressureTestSeries(start time.Time, days ...int) []*BloodPressureMeasurement {
+	measurements := make([]*BloodPressureMeasurement, 0, 2*len(days))
+	for _, day := range days {
+		date := start.AddDate(0, 0, day)
+		measurements = append(measurements,
+			&BloodPressureMeasurement{Systolic: 130, Diastolic: 80, MeasuredAt: model.Datetime{Time: date.Add(8 * time.Hour)}},
+			&BloodPressureMeasurement{Systolic: 140, Diastolic: 90, MeasuredAt: model.Datetime{Time: date.Add(20 * time.Hour)}},
+		)
+	}
+	return measurements
+}
+
+func TestBloodPressureWeeklyAverages(t *testing.T) {
+	// Wednesday
+	start := time.Date(2026, time.October, 7, 0, 0, 0, 0, time.UTC)
+
+	type window struct {
+		start int
+		days  int
+	}
+
+	testCases := []struct {
+		name    string
+		days    []int
+		windows []window
+	}{
+		{
+			name:    "series started mid-week",
+			days:    []int{0, 1, 2, 3, 4, 5, 6},
+			windows: []window{{start: 0, days: 6}},
+		},
+		{
+			name:    "continuous series",
+			days:    []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13},
+			windows: []window{{start: 7, days: 7}, {start: 0, days: 6}},
+		},
+		{
+			name:    "series with the empty day in the next window",
+			days:    []int{0, 1, 2, 3, 9, 10, 12},
+			windows: []window{{start: 7, days: 3}, {start: 0, days: 3}},
+		},
+		{
+			name:    "new series after the empty window",
+			days:    []int{0, 1, 2, 3, 16, 17, 18, 19},
+			windows: []window{{start: 16, days: 3}, {start: 0, days: 3}},
+		},
+		{
+			name:    "first day of the series only",
+			days:    []int{0},
+			windows: []window{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			averages := BloodPressureWeeklyAverages(bloodPressureTestSeries(start, testCase.days...))
+			if len(averages) != len(testCase.windows) {
+				t.Fatalf("got %v averages, want %v", len(averages), len(testCase.windows))
+			}
+
+			for i, expected := range testCase.windows {
+				average := averages[i]
+				if windowStart := start.AddDate(0, 0, expected.start); !average.WeekStart.Equal(windowStart) {
+					t.Errorf("average %v: got window start %v, want %v", i, average.WeekStart.Time, windowStart)
+				}
+				if average.DaysCount != expected.days {
+					t.Errorf("average %v: got %v days, want %v", i, average.DaysCount, expected.days)
+				}
+				if average.Systolic != 135 || average.Diastolic != 85 {
+					t.Errorf("average %v: got %v/%v, want 135/85", i, average.Systolic, average.Diastolic)
+				}
+			}
+		})
+	}
+}
+
+func TestBloodPressureDiaryCriteriaCovers(t *testing.T) {
+	criteria := BloodPressureDiaryCriteria{
+		From: time.Date(2026, time.October, 10, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		windowStart time.Time
+		covered     bool
+	}{
+		{windowStart: time.Date(2026, time.October, 3, 0, 0, 0, 0, time.UTC), covered: false},
+		{windowStart: time.Date(2026, time.October, 4, 0, 0, 0, 0, time.UTC), covered: true},
+		{windowStart: time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC), covered: true},
+		{windowStart: time.Date(2026, time.October, 21, 0, 0, 0, 0, time.UTC), covered: false},
+	}
+
+	for _, testCase := range testCases {
+		average := &BloodPressureWeeklyAverage{WeekStart: model.Date{Time: testCase.windowStart}}
+		if covered := criteria.Covers(average); covered != testCase.covered {
+			t.Errorf("window from %v: got covered %v, want %v", testCase.windowStart, covered, testCase.covered)
+		}
+	}
+}
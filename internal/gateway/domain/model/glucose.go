@@ -0,0 +1,350 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+var (
+	ErrInvalidGlucoseMeasurementType = errors.New("invalid glucose measurement type")
+	ErrInvalidGlucoseValue           = errors.New("invalid glucose value")
+	ErrGlucoseMeasurementNotFound    = errors.New("glucose measurement with this id not found")
+	ErrInvalidGlucoseDiaryCriteria   = errors.New("invalid glucose diary criteria")
+)
+
+// glucoseMeasurementType describes the units of the measurement type and its validation range in the canonical unit.
+type glucoseMeasurementType struct {
+	measurement measurement
+	min         float64
+	max         float64
+}
+
+var glucoseMeasurementTypes = map[string]glucoseMeasurementType{
+	common.GlucoseMeasurementFasting:            {measurement: measurementGlucose, min: 1, max: 35},
+	common.GlucoseMeasurementPostprandial:       {measurement: measurementGlucose, min: 1, max: 35},
+	common.GlucoseMeasurementGlycatedHemoglobin: {measurement: measurementGlycatedHemoglobin, min: 3, max: 20},
+}
+
+// glucoseMeasurementTypesOrder is the order of the glycaemic control indicators
+var glucoseMeasurementTypesOrder = []string{
+	common.GlucoseMeasurementGlycatedHemoglobin,
+	common.GlucoseMeasurementFasting,
+	common.GlucoseMeasurementPostprandial,
+}
+
+// GlucoseMeasurementNames are the names of the glucose measurement types.
+var GlucoseMeasurementNames = map[string]string{
+	common.GlucoseMeasurementFasting:            "Глюкоза натощак",
+	common.GlucoseMeasurementPostprandial:       "Глюкоза через 2 часа после еды",
+	common.GlucoseMeasurementGlycatedHemoglobin: "Гликированный гемоглобин (HbA1c)",
+}
+
+// GlucoseMeasurement represents the single glycaemic diary entry: the glucose level measured by the glucometer or
+// the laboratory HbA1c result.
+type GlucoseMeasurement struct {
+	ID     uint64  `json:"id,omitempty" db:"id"`
+	UserID uint64  `json:"-" db:"user_id"`
+	Type   string  `json:"type" db:"type"`
+	Value  float64 `json:"value" db:"value"`
+	// Unit is optional, the canonical unit of the type is assumed if not set; the glucose levels may be declared in
+	// mg/dL, they are stored in mmol/L
+	Unit string `json:"unit" db:"-"`
+	// MeasuredAt is optional, the current time is assumed if not set
+	MeasuredAt model.Datetime `json:"measuredAt" db:"measured_at"`
+	CreatedAt  model.Datetime `json:"createdAt" db:"created_at"`
+}
+
+// Validate validates the measurement value according to its type. The value declared in the conventional unit is
+// converted to the canonical one.
+func (m *GlucoseMeasurement) Validate() error {
+	measurementType, found := glucoseMeasurementTypes[m.Type]
+	if !found {
+		return fmt.Errorf("%w: %q", ErrInvalidGlucoseMeasurementType, m.Type)
+	}
+
+	switch m.Unit {
+	case "", measurementType.measurement.si:
+	case measurementType.measurement.us:
+		m.Value = roundTo(m.Value*measurementType.measurement.factor, 1)
+	default:
+		return fmt.Errorf("%w: %v can't be measured in %v", ErrInvalidUnits, m.Type, m.Unit)
+	}
+	m.Unit = measurementType.measurement.si
+
+	// the ozzo-validation threshold rules skip the zero values, so the range is checked explicitly
+	if m.Value < measurementType.min || m.Value > measurementType.max {
+		return fmt.Errorf(
+			"%w: %v must be from %v to %v %v",
+			ErrInvalidGlucoseValue, m.Type, measurementType.min, measurementType.max, m.Unit,
+		)
+	}
+
+	return nil
+}
+
+// ConvertUnits converts the canonical value to the unit system and declares the unit of the value.
+func (m *GlucoseMeasurement) ConvertUnits(unitSystem string) {
+	measurementType, found := glucoseMeasurementTypes[m.Type]
+	if !found {
+		return
+	}
+
+	if unitSystem != common.UnitSystemUS {
+		m.Unit = measurementType.measurement.si
+		return
+	}
+
+	m.Value = roundTo(m.Value/measurementType.measurement.factor, measurementType.measurement.usPrecision)
+	m.Unit = measurementType.measurement.us
+}
+
+// GlucoseDiaryCriteria represents the criteria of the user glycaemic diary listing.
+type GlucoseDiaryCriteria struct {
+	UserID uint64
+	// Type is optional, empty means all the types
+	Type string
+	// measurement dates range, the bounds are optional and inclusive
+	From time.Time
+	To   time.Time
+}
+
+func (c GlucoseDiaryCriteria) Validate() error {
+	if err := validation.Validate(c.Type, validation.In(
+		common.GlucoseMeasurementFasting,
+		common.GlucoseMeasurementPostprandial,
+		common.GlucoseMeasurementGlycatedHemoglobin,
+	)); err != nil {
+		return fmt.Errorf("%w: type: %v", ErrInvalidGlucoseDiaryCriteria, err)
+	}
+	if !c.From.IsZero() && !c.To.IsZero() && c.From.After(c.To) {
+		return fmt.Errorf("%w: from must be no later than to", ErrInvalidGlucoseDiaryCriteria)
+	}
+	return nil
+}
+
+// glycaemicTargets are the individual glycaemic targets of the patients with diabetes by the cardiovascular risk
+// categories: the HbA1c target is less stringent for the patients with the established cardiovascular disease because
+// of the risk of hypoglycaemia, the glucose targets correspond to the HbA1c one according to the Russian diabetes care
+// algorithms. The diabetes itself means the high risk at least, the lower categories are listed for completeness.
+var glycaemicTargets = map[string]map[string]float64{
+	common.RiskCategoryLow: {
+		common.GlucoseMeasurementGlycatedHemoglobin: 6.5,
+		common.GlucoseMeasurementFasting:            6.5,
+		common.GlucoseMeasurementPostprandial:       8.0,
+	},
+	common.RiskCategoryModerate: {
+		common.GlucoseMeasurementGlycatedHemoglobin: 7.0,
+		common.GlucoseMeasurementFasting:            7.0,
+		common.GlucoseMeasurementPostprandial:       9.0,
+	},
+	common.RiskCategoryHigh: {
+		common.GlucoseMeasurementGlycatedHemoglobin: 7.0,
+		common.GlucoseMeasurementFasting:            7.0,
+		common.GlucoseMeasurementPostprandial:       9.0,
+	},
+	common.RiskCategoryVeryHigh: {
+		common.GlucoseMeasurementGlycatedHemoglobin: 7.5,
+		common.GlucoseMeasurementFasting:            7.5,
+		common.GlucoseMeasurementPostprandial:       10.0,
+	},
+}
+
+// normoglycaemiaBounds are the upper bounds (exclusive) of the normal glycaemia applied as the targets of the
+// patients without diabetes, the higher values suggest the prediabetes or the undiagnosed diabetes.
+var normoglycaemiaBounds = map[string]float64{
+	common.GlucoseMeasurementGlycatedHemoglobin: 6.0,
+	common.GlucoseMeasurementFasting:            6.1,
+	common.GlucoseMeasurementPostprandial:       7.8,
+}
+
+// hypoglycaemiaLevel is the glucose level (mmol/L, exclusive) below which the hypoglycaemia is recognized.
+const hypoglycaemiaLevel = 3.9
+
+// recentHypoglycaemiaDays is the number of days the hypoglycaemia episodes are counted for.
+const recentHypoglycaemiaDays = 30
+
+// GlycaemicIndicator represents the latest value of the glycaemic control indicator compared with its target.
+type GlycaemicIndicator struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	Unit string `json:"unit"`
+	// Target is the upper bound (exclusive) of the target range
+	Target float64 `json:"target"`
+	// the latest value, nil if the indicator is not measured
+	Value      *float64        `json:"value"`
+	MeasuredAt *model.Datetime `json:"measuredAt,omitempty"`
+	Status     string          `json:"status"`
+	// Change is the difference between the latest and the previous values, nil if there is no previous value
+	Change *float64 `json:"change"`
+}
+
+// GlycaemicControl represents the glycaemic targets of the patient and their attainment.
+type GlycaemicControl struct {
+	// RiskCategory is nil if the data is insufficient to classify the risk
+	RiskCategory       *RiskCategory         `json:"riskCategory"`
+	HasTypeTwoDiabetes bool                  `json:"hasTypeTwoDiabetes"`
+	Indicators         []*GlycaemicIndicator `json:"indicators"`
+	// TargetAttained is nil if none of the indicators is measured, it is false if any of the latest values is out of
+	// the target range
+	TargetAttained *bool `json:"targetAttained"`
+	// RecentHypoglycaemiaCount is the number of the glucose measurements below 3.9 mmol/L for the last 30 days
+	RecentHypoglycaemiaCount int `json:"recentHypoglycaemiaCount"`
+}
+
+// IndicatorsAboveTargetToString lists the indicators above the target with their values and targets.
+func (c *GlycaemicControl) IndicatorsAboveTargetToString() string {
+	targetName := c.TargetName()
+
+	descriptions := make([]string, 0, len(c.Indicators))
+	for _, indicator := range c.Indicators {
+		if indicator.Status != common.GlycaemicStatusAboveTarget {
+			continue
+		}
+		name := []rune(indicator.Name)
+		descriptions = append(descriptions, fmt.Sprintf(
+			"%s %.1f %s (%s – менее %.1f %s)",
+			string(unicode.ToLower(name[0]))+string(name[1:]), *indicator.Value, indicator.Unit,
+			targetName, indicator.Target, indicator.Unit,
+		))
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// TargetName names the indicators targets: the individual targets of the patients with diabetes or the normal
+// glycaemia bounds of the other patients.
+func (c *GlycaemicControl) TargetName() string {
+	if c.HasTypeTwoDiabetes {
+		return "цель"
+	}
+	return "норма"
+}
+
+// EvaluateGlycaemicControl compares the latest glycaemic values with the targets. The targets of the patients
+// with diabetes depend on the cardiovascular risk category, the normal glycaemia bounds are the targets of the other
+// patients. The risk category is nil if the data is insufficient to classify it, that is never the case for the
+// patients with diabetes. The measurements are expected to be sorted from the newest to the oldest and to include the
+// glycaemic lab results, see MergeGlycaemicLabResults.
+func EvaluateGlycaemicControl(
+	riskCategory *RiskCategory, hasTypeTwoDiabetes bool, measurements []*GlucoseMeasurement, now time.Time,
+) *GlycaemicControl {
+	control := &GlycaemicControl{
+		RiskCategory:       riskCategory,
+		HasTypeTwoDiabetes: hasTypeTwoDiabetes,
+		Indicators:         make([]*GlycaemicIndicator, 0, len(glucoseMeasurementTypesOrder)),
+	}
+
+	targets := normoglycaemiaBounds
+	if hasTypeTwoDiabetes && riskCategory != nil {
+		targets = glycaemicTargets[riskCategory.Category]
+	}
+
+	byType := make(map[string][]*GlucoseMeasurement, len(glucoseMeasurementTypesOrder))
+	recentFrom := now.AddDate(0, 0, -recentHypoglycaemiaDays)
+	for _, measurement := range measurements {
+		byType[measurement.Type] = append(byType[measurement.Type], measurement)
+
+		if measurement.Type != common.GlucoseMeasurementGlycatedHemoglobin && measurement.Value < hypoglycaemiaLevel &&
+			!measurement.MeasuredAt.Before(recentFrom) {
+			control.RecentHypoglycaemiaCount++
+		}
+	}
+
+	for _, measurementType := range glucoseMeasurementTypesOrder {
+		indicator := &GlycaemicIndicator{
+			Type:   measurementType,
+			Name:   GlucoseMeasurementNames[measurementType],
+			Unit:   glucoseMeasurementTypes[measurementType].measurement.si,
+			Target: targets[measurementType],
+			Status: common.GlycaemicStatusUnknown,
+		}
+		control.Indicators = append(control.Indicators, indicator)
+
+		typeMeasurements := byType[measurementType]
+		if len(typeMeasurements) == 0 {
+			continue
+		}
+
+		latest := typeMeasurements[0]
+		value := latest.Value
+		indicator.Value = &value
+		if !latest.MeasuredAt.IsZero() {
+			indicator.MeasuredAt = &model.Datetime{Time: latest.MeasuredAt.Time}
+		}
+		if len(typeMeasurements) > 1 {
+			change := roundTo(value-typeMeasurements[1].Value, 1)
+			indicator.Change = &change
+		}
+
+		switch {
+		case measurementType != common.GlucoseMeasurementGlycatedHemoglobin && value < hypoglycaemiaLevel:
+			indicator.Status = common.GlycaemicStatusHypoglycaemia
+		case value < indicator.Target:
+			indicator.Status = common.GlycaemicStatusOnTarget
+		default:
+			indicator.Status = common.GlycaemicStatusAboveTarget
+		}
+
+		targetAttained := control.TargetAttained == nil || *control.TargetAttained
+		targetAttained = targetAttained && indicator.Status == common.GlycaemicStatusOnTarget
+		control.TargetAttained = &targetAttained
+	}
+
+	return control
+}
+
+// glycaemicLabTests are the measurement types of the lab tests results which are the glycaemic values, the laboratory
+// glucose is measured on an empty stomach.
+var glycaemicLabTests = map[string]string{
+	common.LabTestGlucose:            common.GlucoseMeasurementFasting,
+	common.LabTestGlycatedHemoglobin: common.GlucoseMeasurementGlycatedHemoglobin,
+}
+
+// MergeGlycaemicLabResults returns the glycaemic diary measurements together with the glucose and HbA1c lab results,
+// so the glycaemic values are the same whichever way they are entered. Both are expected to be sorted from the newest
+// to the oldest, so are the returned measurements; the diary measurement goes first if the times are equal. The
+// results of the other lab tests are skipped.
+func MergeGlycaemicLabResults(measurements []*GlucoseMeasurement, labResults []*LabResult) []*GlucoseMeasurement {
+	merged := make([]*GlucoseMeasurement, 0, len(measurements)+len(labResults))
+	merged = append(merged, measurements...)
+	for _, result := range labResults {
+		measurementType, found := glycaemicLabTests[result.TestCode]
+		if !found {
+			continue
+		}
+		// the lab results are stored in the catalog test units, that are the canonical ones
+		merged = append(merged, &GlucoseMeasurement{
+			UserID:     result.UserID,
+			Type:       measurementType,
+			Value:      result.Value,
+			Unit:       glucoseMeasurementTypes[measurementType].measurement.si,
+			MeasuredAt: result.CollectedAt,
+		})
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].MeasuredAt.After(merged[j].MeasuredAt.Time)
+	})
+
+	return merged
+}
+
+// LatestFastingGlucose returns the latest fasting glucose level (mmol/L) of the glycaemic diary and the glucose lab
+// results, both are expected to be sorted from the newest to the oldest. If the level is unknown, nil is returned.
+func LatestFastingGlucose(measurements []*GlucoseMeasurement, glucoseResults []*LabResult) *float64 {
+	for _, measurement := range MergeGlycaemicLabResults(measurements, glucoseResults) {
+		if measurement.Type == common.GlucoseMeasurementFasting {
+			value := measurement.Value
+			return &value
+		}
+	}
+	return nil
+}
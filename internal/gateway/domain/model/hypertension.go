@@ -0,0 +1,133 @@
+package model
+
+import (
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+// blood pressure categories ordered by the severity, the isolated systolic hypertension is graded by the systolic
+// pressure, see hypertensionSeverity
+var hypertensionCategoriesBySeverity = []string{
+	common.HypertensionCategoryOptimal,
+	common.HypertensionCategoryNormal,
+	common.HypertensionCategoryHighNormal,
+	common.HypertensionCategoryGrade1,
+	common.HypertensionCategoryGrade2,
+	common.HypertensionCategoryGrade3,
+}
+
+// hypertensionSeverityGrade1 is the severity of the grade 1 hypertension, the severities of the hypertension grades
+// follow it.
+const hypertensionSeverityGrade1 = 3
+
+// the lower bounds of the blood pressure categories (except the optimal one) in the order of the severity
+var (
+	systolicCategoryBounds  = []float64{120, 130, 140, 160, 180}
+	diastolicCategoryBounds = []float64{80, 85, 90, 100, 110}
+)
+
+// isolatedSystolicHypertensionDiastolicBound is the upper bound (exclusive) of the diastolic pressure of the isolated
+// systolic hypertension.
+const isolatedSystolicHypertensionDiastolicBound = 90
+
+// ClassifyBloodPressure returns the ESC/ESH blood pressure category of the reading. The category is determined by the
+// highest of the systolic and the diastolic pressure categories. If the diastolic pressure is unknown (nil), the
+// reading is classified by the systolic pressure only and the isolated systolic hypertension is not recognized.
+func ClassifyBloodPressure(systolic float64, diastolic *float64) string {
+	severity := boundsSeverity(systolic, systolicCategoryBounds)
+
+	if diastolic != nil {
+		if severity >= hypertensionSeverityGrade1 && *diastolic < isolatedSystolicHypertensionDiastolicBound {
+			return common.HypertensionCategoryIsolatedSystolic
+		}
+
+		if diastolicSeverity := boundsSeverity(*diastolic, diastolicCategoryBounds); diastolicSeverity > severity {
+			severity = diastolicSeverity
+		}
+	}
+
+	return hypertensionCategoriesBySeverity[severity]
+}
+
+func boundsSeverity(value float64, bounds []float64) int {
+	severity := 0
+	for _, bound := range bounds {
+		if value >= bound {
+			severity++
+		}
+	}
+	return severity
+}
+
+// IsHypertension returns true if the category is one of the hypertension grades or the isolated systolic hypertension.
+func IsHypertension(category string) bool {
+	return category == common.HypertensionCategoryIsolatedSystolic ||
+		hypertensionSeverity(category, 0) >= hypertensionSeverityGrade1
+}
+
+// HypertensionGrade returns the hypertension grade (1-3) of the category, the isolated systolic hypertension is graded
+// by the systolic pressure. If the category is not a hypertension one, 0 is returned.
+func HypertensionGrade(category string, systolic float64) int {
+	if grade := hypertensionSeverity(category, systolic) - hypertensionSeverityGrade1 + 1; grade > 0 {
+		return grade
+	}
+	return 0
+}
+
+// hypertensionSeverity returns the severity of the category, the isolated systolic hypertension has the severity of
+// the hypertension grade of the systolic pressure.
+func hypertensionSeverity(category string, systolic float64) int {
+	if category == common.HypertensionCategoryIsolatedSystolic {
+		return boundsSeverity(systolic, systolicCategoryBounds)
+	}
+
+	for severity, severityCategory := range hypertensionCategoriesBySeverity {
+		if severityCategory == category {
+			return severity
+		}
+	}
+	return 0
+}
+
+// HypertensionClassification represents the blood pressure category of the reading of the basic indicators or the
+// blood pressure diary.
+type HypertensionClassification struct {
+	Category string `json:"category"`
+	// Grade is the hypertension grade (1-3) of the category, 0 for the categories without hypertension
+	Grade     int      `json:"grade"`
+	Systolic  float64  `json:"systolic"`
+	Diastolic *float64 `json:"diastolic"` // nil if the reading has the systolic pressure only
+	Source    string   `json:"source"`
+	// PreviousCategory and Transition are set if the category severity differs from the previous reading one
+	PreviousCategory string         `json:"previousCategory,omitempty"`
+	Transition       string         `json:"transition,omitempty"`
+	MeasuredAt       model.Datetime `json:"measuredAt"`
+}
+
+// FlagHypertensionTransitions flags the transitions between the categories of the classifications sorted from the
+// newest to the oldest reading.
+func FlagHypertensionTransitions(classifications []*HypertensionClassification) {
+	for i := 0; i < len(classifications)-1; i++ {
+		current, previous := classifications[i], classifications[i+1]
+
+		currentSeverity := hypertensionSeverity(current.Category, current.Systolic)
+		previousSeverity := hypertensionSeverity(previous.Category, previous.Systolic)
+
+		switch {
+		case currentSeverity > previousSeverity:
+			current.Transition = common.HypertensionTransitionWorsened
+		case currentSeverity < previousSeverity:
+			current.Transition = common.HypertensionTransitionImproved
+		default:
+			continue
+		}
+		current.PreviousCategory = previous.Category
+	}
+}
+
+// HypertensionHistory represents the current blood pressure category of the user and the categories over time.
+type HypertensionHistory struct {
+	// Current is nil if the user has no blood pressure readings
+	Current         *HypertensionClassification   `json:"current"`
+	Classifications []*HypertensionClassification `json:"classifications"`
+}
@@ -0,0 +1,164 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+var (
+	ErrInvalidLabTestCode    = errors.New("invalid code value")
+	ErrInvalidLabTestName    = errors.New("invalid name value")
+	ErrInvalidLabTestRange   = errors.New("invalid min and max values")
+	ErrInvalidLabTestData    = errors.New("invalid lab test data")
+	ErrLabTestNotFound       = errors.New("lab test with this code not found")
+	ErrLabTestAlreadyExists  = errors.New("lab test with this code already exists")
+	ErrLabTestBuiltIn        = errors.New("built-in lab test cannot be deleted")
+	ErrLabTestInUse          = errors.New("lab test has results and cannot be deleted")
+	ErrInvalidLabResultValue = errors.New("invalid lab result value")
+	ErrInvalidLabResultsData = errors.New("invalid lab results data")
+	ErrUnknownLabTest        = errors.New("unknown lab test code")
+)
+
+// MaxLabResults is the maximum number of the lab results of the same collection.
+const MaxLabResults = 50
+
+var labTestCodeRegexp = regexp.MustCompile("^[a-zA-Z][a-zA-Z0-9]*$")
+
+// LabTest represents the lab tests catalog entry. The lab results of the test are expected to be in the test unit and
+// within the test validation range.
+type LabTest struct {
+	Code string `json:"code" db:"code"`
+	Name string `json:"name" db:"name"`
+	Unit string `json:"unit" db:"unit"`
+	// validation range, the bounds are inclusive and optional
+	Min *float64 `json:"min" db:"value_min"`
+	Max *float64 `json:"max" db:"value_max"`
+	// BuiltIn is true for the tests of the analyses (see Analysis LabTestValues), they cannot be deleted
+	BuiltIn   bool           `json:"builtIn" db:"built_in"`
+	CreatedAt model.Datetime `json:"createdAt" db:"created_at"`
+}
+
+func (t LabTest) Validate() error {
+	err := validation.ValidateStruct(&t,
+		validation.Field(&t.Code, validation.Required, validation.Length(1, 255), validation.Match(labTestCodeRegexp)),
+		validation.Field(&t.Name, validation.Required, validation.Length(1, 255)),
+		validation.Field(&t.Unit, validation.Length(0, 255)),
+	)
+	if err != nil {
+		var errBytes []byte
+		errBytes, err = json.Marshal(err)
+		if err != nil {
+			return err
+		}
+
+		var validationErrors map[string]string
+		if err = json.Unmarshal(errBytes, &validationErrors); err != nil {
+			return err
+		}
+
+		if validationError, found := validationErrors["code"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidLabTestCode, validationError)
+		}
+		if validationError, found := validationErrors["name"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidLabTestName, validationError)
+		}
+
+		return ErrInvalidLabTestData
+	}
+
+	if t.Min != nil && t.Max != nil && *t.Min > *t.Max {
+		return fmt.Errorf("%w: min must be no greater than max", ErrInvalidLabTestRange)
+	}
+
+	return nil
+}
+
+// rules returns the validation rules of the test range.
+func (t *LabTest) rules() []validation.Rule {
+	if t == nil {
+		return nil
+	}
+
+	rules := make([]validation.Rule, 0, 2)
+	if t.Min != nil {
+		rules = append(rules, validation.Min(*t.Min))
+	}
+	if t.Max != nil {
+		rules = append(rules, validation.Max(*t.Max))
+	}
+	return rules
+}
+
+// LabTestsByCode returns the lab tests mapped by their codes.
+func LabTestsByCode(labTests []*LabTest) map[string]*LabTest {
+	labTestsByCode := make(map[string]*LabTest, len(labTests))
+	for _, labTest := range labTests {
+		labTestsByCode[labTest.Code] = labTest
+	}
+	return labTestsByCode
+}
+
+// LabResult represents the single lab test result. The results of the same collection are grouped by the analysis.
+type LabResult struct {
+	ID          uint64         `json:"id,omitempty" db:"id"`
+	UserID      uint64         `json:"-" db:"user_id"`
+	AnalysisID  uint64         `json:"analysisId,omitempty" db:"analysis_id"`
+	TestCode    string         `json:"testCode" db:"test_code"`
+	Unit        string         `json:"unit" db:"unit"` // optional, the test unit is assumed if not set
+	Value       float64        `json:"value" db:"value"`
+	CollectedAt model.Datetime `json:"collectedAt" db:"collected_at"` // optional, the current time is assumed if not set
+}
+
+// Validate validates the result according to the catalog test. The test unit is set if the result unit is not set.
+func (r *LabResult) Validate(labTest *LabTest) error {
+	if labTest == nil {
+		return fmt.Errorf("%w: %q", ErrUnknownLabTest, r.TestCode)
+	}
+
+	if r.Unit == "" {
+		r.Unit = labTest.Unit
+	}
+	if r.Unit != labTest.Unit {
+		return fmt.Errorf("%w: %v expected for %v", ErrInvalidUnits, labTest.Unit, r.TestCode)
+	}
+
+	// the ozzo-validation threshold rules skip the zero values, so the range is checked explicitly
+	if labTest.Min != nil && r.Value < *labTest.Min {
+		return fmt.Errorf("%w: %v must be no less than %v", ErrInvalidLabResultValue, r.TestCode, *labTest.Min)
+	}
+	if labTest.Max != nil && r.Value > *labTest.Max {
+		return fmt.Errorf("%w: %v must be no greater than %v", ErrInvalidLabResultValue, r.TestCode, *labTest.Max)
+	}
+
+	return nil
+}
+
+// ValidateLabResults validates the results of the same collection according to the lab tests catalog.
+func ValidateLabResults(results []*LabResult, labTests map[string]*LabTest) error {
+	if len(results) == 0 || len(results) > MaxLabResults {
+		return fmt.Errorf("%w: from 1 to %v results expected", ErrInvalidLabResultsData, MaxLabResults)
+	}
+
+	testCodes := make(map[string]bool, len(results))
+	for _, result := range results {
+		if result == nil {
+			return fmt.Errorf("%w: empty result", ErrInvalidLabResultsData)
+		}
+		if testCodes[result.TestCode] {
+			return fmt.Errorf("%w: duplicated test code %q", ErrInvalidLabResultsData, result.TestCode)
+		}
+		testCodes[result.TestCode] = true
+
+		if err := result.Validate(labTests[result.TestCode]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
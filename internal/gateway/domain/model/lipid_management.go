@@ -0,0 +1,90 @@
+package model
+
+import (
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+// ldlCholesterolGoals are the LDL cholesterol goals (mmol/L, exclusive upper bounds) by the risk categories according
+// to the ESC/EAS 2019 dyslipidaemias guidelines. The guidelines also require at least 50% reduction from the baseline
+// for the high and very high risk, it is not tracked since the level before the therapy is usually unknown.
+var ldlCholesterolGoals = map[string]float64{
+	common.RiskCategoryLow:      3.0,
+	common.RiskCategoryModerate: 2.6,
+	common.RiskCategoryHigh:     1.8,
+	common.RiskCategoryVeryHigh: 1.4,
+}
+
+// LipidManagement represents the LDL cholesterol goal attainment and the lipid-lowering therapy indication.
+type LipidManagement struct {
+	RiskCategory *RiskCategory `json:"riskCategory"`
+	// LDLCholesterolGoal is the LDL cholesterol level (mmol/L) to stay below
+	LDLCholesterolGoal float64 `json:"ldlCholesterolGoal"`
+	// the latest LDL cholesterol level (mmol/L), nil if unknown
+	LDLCholesterol           *float64        `json:"ldlCholesterol"`
+	LDLCholesterolMeasuredAt *model.Datetime `json:"ldlCholesterolMeasuredAt,omitempty"`
+	// LDLCholesterolComputed is true if the level is estimated by the Friedewald formula
+	LDLCholesterolComputed bool `json:"ldlCholesterolComputed"`
+	// GoalAttained is nil if LDL cholesterol is unknown
+	GoalAttained *bool `json:"goalAttained"`
+	// ReductionNeeded is the LDL cholesterol reduction in percents still needed to attain the goal, nil if unknown
+	ReductionNeeded *float64 `json:"reductionNeeded"`
+	TakesStatins    bool     `json:"takesStatins"`
+	Therapy         string   `json:"therapy"`
+}
+
+// EvaluateLipidManagement derives the LDL cholesterol goal from the risk category and checks whether the latest LDL
+// cholesterol level meets it. The analyses are expected to be sorted from the newest to the oldest with the derived
+// values calculated (see Analysis.Derive). The patient is the candidate for starting the statin therapy if the goal is
+// not attained and the risk is high or very high or LDL cholesterol is markedly elevated, otherwise the lifestyle
+// intervention is indicated first; the therapy is to be intensified if the goal is not attained on statins.
+func EvaluateLipidManagement(riskCategory *RiskCategory, analyses []*Analysis, takesStatins bool) *LipidManagement {
+	management := &LipidManagement{
+		RiskCategory:       riskCategory,
+		LDLCholesterolGoal: ldlCholesterolGoals[riskCategory.Category],
+		TakesStatins:       takesStatins,
+		Therapy:            common.LipidTherapyUnknown,
+	}
+
+	for _, analysis := range analyses {
+		if analysis.LowDensityCholesterol != nil {
+			management.LDLCholesterol = analysis.LowDensityCholesterol
+			if !analysis.CreatedAt.IsZero() {
+				management.LDLCholesterolMeasuredAt = &model.Datetime{Time: analysis.CreatedAt.Time}
+			}
+			management.LDLCholesterolComputed = analysis.IsComputed(common.AnalysisValueLowDensityCholesterol)
+			break
+		}
+	}
+
+	if management.LDLCholesterol == nil {
+		return management
+	}
+	ldlCholesterol := *management.LDLCholesterol
+
+	goalAttained := ldlCholesterol < management.LDLCholesterolGoal
+	management.GoalAttained = &goalAttained
+
+	var reductionNeeded float64
+	if !goalAttained && ldlCholesterol > 0 {
+		reductionNeeded = roundTo((ldlCholesterol-management.LDLCholesterolGoal)/ldlCholesterol*100, 1)
+	}
+	management.ReductionNeeded = &reductionNeeded
+
+	highRisk := riskCategory.Category == common.RiskCategoryHigh || riskCategory.Category == common.RiskCategoryVeryHigh
+
+	switch {
+	case goalAttained && takesStatins:
+		management.Therapy = common.LipidTherapyContinue
+	case goalAttained:
+		management.Therapy = common.LipidTherapyNotIndicated
+	case takesStatins:
+		management.Therapy = common.LipidTherapyIntensify
+	case highRisk || ldlCholesterol > markedlyElevatedLDLLevel:
+		management.Therapy = common.LipidTherapyStart
+	default:
+		management.Therapy = common.LipidTherapyLifestyle
+	}
+
+	return management
+}
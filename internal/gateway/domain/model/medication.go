@@ -0,0 +1,280 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+var (
+	ErrInvalidMedicationName        = errors.New("invalid name value")
+	ErrInvalidMedicationClass       = errors.New("invalid class value")
+	ErrInvalidMedicationDose        = errors.New("invalid dose value")
+	ErrInvalidMedicationDosesPerDay = errors.New("invalid dosesPerDay value")
+	ErrInvalidMedicationDates       = errors.New("invalid startDate and stopDate values")
+	ErrInvalidMedicationData        = errors.New("invalid medication data")
+	ErrMedicationNotFound           = errors.New("medication with this id not found")
+	ErrInvalidMedicationIntake      = errors.New("invalid medication intake")
+	ErrMedicationIntakeNotFound     = errors.New("medication intake with this id not found")
+	ErrInvalidAdherenceCriteria     = errors.New("invalid adherence criteria")
+)
+
+// MaxDosesPerDay is the maximum number of the doses of the medication per day.
+const MaxDosesPerDay = 12
+
+// MaxAdherencePeriodDays is the maximum length of the adherence calculation period.
+const MaxAdherencePeriodDays = 366
+
+// DefaultAdherencePeriodDays is the length of the adherence calculation period ending today if the period is not set.
+const DefaultAdherencePeriodDays = 30
+
+// lipid-lowering medications classes, the user is considered to take statins if any of them is active
+var lipidLoweringMedicationClasses = map[string]bool{
+	common.MedicationClassStatin:         true,
+	common.MedicationClassEzetimibe:      true,
+	common.MedicationClassPCSK9Inhibitor: true,
+	common.MedicationClassFibrate:        true,
+	common.MedicationClassBempedoicAcid:  true,
+}
+
+// Medication represents the drug of the user medication list.
+type Medication struct {
+	ID     uint64 `json:"id,omitempty" db:"id"`
+	UserID uint64 `json:"-" db:"user_id"`
+	Name   string `json:"name" db:"name"`
+	Class  string `json:"class" db:"drug_class"`
+	Dose   string `json:"dose" db:"dose"` // optional, e.g. "20 мг"
+	// DosesPerDay is the schedule of the medication, the number of the doses expected per day
+	DosesPerDay int        `json:"dosesPerDay" db:"doses_per_day"`
+	StartDate   model.Date `json:"startDate" db:"start_date"`
+	// StopDate is the last day of the medication, nil if the medication is ongoing
+	StopDate  *model.Date    `json:"stopDate" db:"stop_date"`
+	Active    bool           `json:"active" db:"-"` // whether the medication is taken today
+	CreatedAt model.Datetime `json:"createdAt" db:"created_at"`
+}
+
+func (m Medication) Validate() error {
+	err := validation.ValidateStruct(&m,
+		validation.Field(&m.Name, validation.Required, validation.Length(1, 255)),
+		validation.Field(&m.Class, validation.Required, validation.In(
+			common.MedicationClassStatin,
+			common.MedicationClassEzetimibe,
+			common.MedicationClassPCSK9Inhibitor,
+			common.MedicationClassFibrate,
+			common.MedicationClassBempedoicAcid,
+			common.MedicationClassAntihypertensive,
+			common.MedicationClassAntiplatelet,
+			common.MedicationClassAnticoagulant,
+			common.MedicationClassAntidiabetic,
+			common.MedicationClassOther,
+		)),
+		validation.Field(&m.Dose, validation.Length(0, 255)),
+		validation.Field(&m.DosesPerDay, validation.Required, validation.Min(1), validation.Max(MaxDosesPerDay)),
+	)
+	if err != nil {
+		var errBytes []byte
+		errBytes, err = json.Marshal(err)
+		if err != nil {
+			return err
+		}
+
+		var validationErrors map[string]string
+		if err = json.Unmarshal(errBytes, &validationErrors); err != nil {
+			return err
+		}
+
+		if validationError, found := validationErrors["name"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidMedicationName, validationError)
+		}
+		if validationError, found := validationErrors["class"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidMedicationClass, validationError)
+		}
+		if validationError, found := validationErrors["dose"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidMedicationDose, validationError)
+		}
+		if validationError, found := validationErrors["dosesPerDay"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidMedicationDosesPerDay, validationError)
+		}
+
+		return ErrInvalidMedicationData
+	}
+
+	if m.StartDate.IsZero() {
+		return fmt.Errorf("%w: startDate is required", ErrInvalidMedicationDates)
+	}
+	if m.StopDate != nil && m.StopDate.Before(m.StartDate.Time) {
+		return fmt.Errorf("%w: stopDate must be no earlier than startDate", ErrInvalidMedicationDates)
+	}
+
+	return nil
+}
+
+// SetStopDate sets the stop date of the medication, nil means the medication is ongoing.
+func (m *Medication) SetStopDate(stopDate *time.Time) {
+	if stopDate == nil {
+		m.StopDate = nil
+		return
+	}
+	m.StopDate = &model.Date{Time: *stopDate}
+}
+
+// ActiveOn returns true if the medication is taken on the day of the time.
+func (m *Medication) ActiveOn(t time.Time) bool {
+	day := dateOf(t)
+	if day.Before(dateOf(m.StartDate.Time)) {
+		return false
+	}
+	return m.StopDate == nil || !day.After(dateOf(m.StopDate.Time))
+}
+
+// LipidLowering returns true if the medication is a lipid-lowering one (statins, ezetimibe, etc.).
+func (m *Medication) LipidLowering() bool {
+	return lipidLoweringMedicationClasses[m.Class]
+}
+
+// TakesLipidLoweringMedications returns true if any of the medications is an active lipid-lowering one.
+func TakesLipidLoweringMedications(medications []*Medication, now time.Time) bool {
+	for _, medication := range medications {
+		if medication.LipidLowering() && medication.ActiveOn(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// MedicationIntake represents the dose of the medication marked as taken.
+type MedicationIntake struct {
+	ID           uint64 `json:"id,omitempty" db:"id"`
+	UserID       uint64 `json:"-" db:"user_id"`
+	MedicationID uint64 `json:"medicationId" db:"medication_id"`
+	// TakenAt is optional, the current time is assumed if not set
+	TakenAt model.Datetime `json:"takenAt" db:"taken_at"`
+}
+
+// Validate checks that the intake is within the medication period and is not in the future.
+func (i MedicationIntake) Validate(medication *Medication, now time.Time) error {
+	if i.TakenAt.After(now) {
+		return fmt.Errorf("%w: takenAt must not be in the future", ErrInvalidMedicationIntake)
+	}
+	if !medication.ActiveOn(i.TakenAt.Time) {
+		return fmt.Errorf("%w: takenAt must be within the medication period", ErrInvalidMedicationIntake)
+	}
+	return nil
+}
+
+// MedicationAdherenceCriteria represents the period of the adherence calculation, the bounds are inclusive.
+type MedicationAdherenceCriteria struct {
+	UserID uint64
+	From   time.Time
+	To     time.Time
+}
+
+func (c MedicationAdherenceCriteria) Validate() error {
+	if c.From.After(c.To) {
+		return fmt.Errorf("%w: from must be no later than to", ErrInvalidAdherenceCriteria)
+	}
+	if periodDays(c.From, c.To) > MaxAdherencePeriodDays {
+		return fmt.Errorf("%w: the period must not exceed %v days", ErrInvalidAdherenceCriteria, MaxAdherencePeriodDays)
+	}
+	return nil
+}
+
+// MedicationAdherence represents the objective adherence to the drug therapy, i.e. the share of the expected doses
+// marked as taken.
+type MedicationAdherence struct {
+	From          model.Date `json:"from"`
+	To            model.Date `json:"to"`
+	ExpectedDoses int        `json:"expectedDoses"`
+	TakenDoses    int        `json:"takenDoses"`
+	// Percentage is nil if no doses are expected within the period
+	Percentage  *float64                         `json:"percentage"`
+	Medications []*MedicationAdherenceMedication `json:"medications"`
+}
+
+type MedicationAdherenceMedication struct {
+	MedicationID  uint64   `json:"medicationId"`
+	Name          string   `json:"name"`
+	ExpectedDoses int      `json:"expectedDoses"`
+	TakenDoses    int      `json:"takenDoses"`
+	Percentage    *float64 `json:"percentage"`
+}
+
+// CalculateMedicationAdherence calculates the adherence within the criteria period. The doses are expected on the
+// days the medication is active according to its schedule, the doses taken over the schedule of the day are not
+// counted.
+func CalculateMedicationAdherence(
+	criteria MedicationAdherenceCriteria, medications []*Medication, intakes []*MedicationIntake,
+) *MedicationAdherence {
+	takenByDay := make(map[uint64]map[time.Time]int, len(medications))
+	for _, intake := range intakes {
+		if takenByDay[intake.MedicationID] == nil {
+			takenByDay[intake.MedicationID] = make(map[time.Time]int)
+		}
+		takenByDay[intake.MedicationID][dateOf(intake.TakenAt.Time)]++
+	}
+
+	from, to := dateOf(criteria.From), dateOf(criteria.To)
+
+	adherence := &MedicationAdherence{
+		From:        model.Date{Time: from},
+		To:          model.Date{Time: to},
+		Medications: make([]*MedicationAdherenceMedication, 0, len(medications)),
+	}
+	for _, medication := range medications {
+		medicationAdherence := &MedicationAdherenceMedication{
+			MedicationID: medication.ID,
+			Name:         medication.Name,
+		}
+
+		for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+			if !medication.ActiveOn(day) {
+				continue
+			}
+
+			taken := takenByDay[medication.ID][day]
+			if taken > medication.DosesPerDay {
+				taken = medication.DosesPerDay
+			}
+
+			medicationAdherence.ExpectedDoses += medication.DosesPerDay
+			medicationAdherence.TakenDoses += taken
+		}
+
+		if medicationAdherence.ExpectedDoses == 0 {
+			continue
+		}
+		medicationAdherence.Percentage = adherencePercentage(medicationAdherence.TakenDoses, medicationAdherence.ExpectedDoses)
+
+		adherence.ExpectedDoses += medicationAdherence.ExpectedDoses
+		adherence.TakenDoses += medicationAdherence.TakenDoses
+		adherence.Medications = append(adherence.Medications, medicationAdherence)
+	}
+
+	if adherence.ExpectedDoses > 0 {
+		adherence.Percentage = adherencePercentage(adherence.TakenDoses, adherence.ExpectedDoses)
+	}
+
+	return adherence
+}
+
+func adherencePercentage(taken, expected int) *float64 {
+	percentage := roundTo(float64(taken)/float64(expected)*100, 1)
+	return &percentage
+}
+
+// dateOf returns the date of the time as the UTC midnight, so the dates of the different locations are comparable.
+func dateOf(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// periodDays returns the number of the days of the period, the bounds are inclusive.
+func periodDays(from, to time.Time) int {
+	return int(dateOf(to).Sub(dateOf(from)).Hours()/24) + 1
+}
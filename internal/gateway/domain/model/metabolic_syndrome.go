@@ -0,0 +1,492 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+// MetabolicSyndromeDataNames are the names of the data the metabolic syndrome criteria are evaluated by.
+var MetabolicSyndromeDataNames = map[string]string{
+	common.MetabolicSyndromeDataGender:         "пол",
+	common.MetabolicSyndromeDataWaistSize:      "окружность талии",
+	common.MetabolicSyndromeDataTriglycerides:  "триглицериды",
+	common.MetabolicSyndromeDataHDLCholesterol: "холестерин ЛПВП",
+	common.MetabolicSyndromeDataSBPLevel:       "систолическое артериальное давление",
+	common.MetabolicSyndromeDataDBPLevel:       "диастолическое артериальное давление",
+	common.MetabolicSyndromeDataGlucose:        "глюкоза крови натощак",
+}
+
+// metabolicSyndromeDataOrder is the order of the missing data in the screening result
+var metabolicSyndromeDataOrder = []string{
+	common.MetabolicSyndromeDataGender,
+	common.MetabolicSyndromeDataWaistSize,
+	common.MetabolicSyndromeDataTriglycerides,
+	common.MetabolicSyndromeDataHDLCholesterol,
+	common.MetabolicSyndromeDataSBPLevel,
+	common.MetabolicSyndromeDataDBPLevel,
+	common.MetabolicSyndromeDataGlucose,
+}
+
+var metabolicSyndromeDefinitionNames = map[string]string{
+	common.MetabolicSyndromeDefinitionNCEP: "NCEP ATP III",
+	common.MetabolicSyndromeDefinitionIDF:  "IDF",
+}
+
+// metabolicSyndromeCriteriaOrder is the order of the criteria in the definitions results
+var metabolicSyndromeCriteriaOrder = []string{
+	common.MetabolicSyndromeCriterionWaistSize,
+	common.MetabolicSyndromeCriterionTriglycerides,
+	common.MetabolicSyndromeCriterionHDLCholesterol,
+	common.MetabolicSyndromeCriterionBloodPressure,
+	common.MetabolicSyndromeCriterionGlucose,
+}
+
+var metabolicSyndromeCriterionNames = map[string]string{
+	common.MetabolicSyndromeCriterionWaistSize:      "Абдоминальное ожирение",
+	common.MetabolicSyndromeCriterionTriglycerides:  "Повышенные триглицериды",
+	common.MetabolicSyndromeCriterionHDLCholesterol: "Сниженный холестерин ЛПВП",
+	common.MetabolicSyndromeCriterionBloodPressure:  "Повышенное артериальное давление",
+	common.MetabolicSyndromeCriterionGlucose:        "Повышенная глюкоза натощак",
+}
+
+// the metabolic syndrome criteria thresholds, the triglycerides, blood pressure and glucose ones are the same for both
+// definitions
+const (
+	metabolicSyndromeTriglyceridesLevel = 1.7   // mmol/L, inclusive
+	metabolicSyndromeSBPLevel           = 130.0 // mm Hg, inclusive
+	metabolicSyndromeDBPLevel           = 85.0  // mm Hg, inclusive
+	metabolicSyndromeGlucoseLevel       = 5.6   // mmol/L, inclusive
+)
+
+// the number of the criteria required by the definitions, the IDF one requires the central obesity and two of the
+// other criteria
+const (
+	metabolicSyndromeNCEPCriteriaRequired = 3
+	metabolicSyndromeIDFCriteriaRequired  = 2
+)
+
+// sexSpecificThreshold represents the threshold of the criterion differing for men and women, the criterion is met
+// if the value exceeds the threshold (or reaches it if inclusive is set) or falls below it if below is set.
+type sexSpecificThreshold struct {
+	male      float64
+	female    float64
+	inclusive bool
+	below     bool
+}
+
+var (
+	ncepWaistSizeThreshold  = sexSpecificThreshold{male: 102, female: 88}
+	idfWaistSizeThreshold   = sexSpecificThreshold{male: 94, female: 80, inclusive: true}
+	hdlCholesterolThreshold = sexSpecificThreshold{male: 1.03, female: 1.29, below: true}
+)
+
+func (t sexSpecificThreshold) exceeded(value, threshold float64) bool {
+	switch {
+	case t.below:
+		return value < threshold
+	case t.inclusive:
+		return value >= threshold
+	default:
+		return value > threshold
+	}
+}
+
+// status returns the criterion status of the value. If the gender is unknown, the criterion is still evaluated if the
+// thresholds of both genders give the same result, otherwise the gender is needed.
+func (t sexSpecificThreshold) status(value float64, gender string) (status string, genderNeeded bool) {
+	maleExceeded, femaleExceeded := t.exceeded(value, t.male), t.exceeded(value, t.female)
+
+	var exceeded bool
+	switch {
+	case gender == common.UserGenderMale:
+		exceeded = maleExceeded
+	case gender == common.UserGenderFemale:
+		exceeded = femaleExceeded
+	case maleExceeded == femaleExceeded:
+		exceeded = maleExceeded
+	default:
+		return common.MetabolicSyndromeCriterionMissing, true
+	}
+
+	if exceeded {
+		return common.MetabolicSyndromeCriterionMet, false
+	}
+	return common.MetabolicSyndromeCriterionNotMet, false
+}
+
+// MetabolicSyndromeData represents the patient data the metabolic syndrome criteria are evaluated by.
+type MetabolicSyndromeData struct {
+	Gender string // empty if unknown
+	// the latest known values, nil if unknown
+	WaistSize      *float64
+	Triglycerides  *float64
+	HDLCholesterol *float64
+	SBPLevel       *float64
+	DBPLevel       *float64
+	// Glucose is the fasting plasma glucose level (mmol/L)
+	Glucose *float64
+	// the diagnosed hypertension and diabetes meet the blood pressure and the glucose criteria regardless of the values
+	HasArterialHypertension bool
+	HasTypeTwoDiabetes      bool
+}
+
+// NewMetabolicSyndromeData collects the metabolic syndrome data from the SCORE data, the diseases, the basic
+// indicators, the analyses and the latest fasting glucose level, see LatestFastingGlucose. The records are expected to
+// be sorted from the newest to the oldest. The diastolic pressure is taken from the latest sufficient blood pressure
+// diary weekly average.
+func NewMetabolicSyndromeData(
+	scoreData ScoreData,
+	bloodPressureAverages []*BloodPressureWeeklyAverage,
+	diseases *Diseases,
+	basicIndicators []*BasicIndicators,
+	analyses []*Analysis,
+	fastingGlucose *float64,
+) MetabolicSyndromeData {
+	data := MetabolicSyndromeData{
+		Glucose: fastingGlucose,
+	}
+
+	if scoreData.Gender == common.UserGenderMale || scoreData.Gender == common.UserGenderFemale {
+		data.Gender = scoreData.Gender
+	}
+	if scoreData.SBPLevel > 0 {
+		sbpLevel := scoreData.SBPLevel
+		data.SBPLevel = &sbpLevel
+	}
+	if average := LatestSufficientBloodPressureAverage(bloodPressureAverages); average != nil {
+		dbpLevel := average.Diastolic
+		data.DBPLevel = &dbpLevel
+	}
+
+	if diseases != nil {
+		data.HasArterialHypertension = diseases.HasArterialHypertension
+		data.HasTypeTwoDiabetes = diseases.HasTypeTwoDiabetes
+	}
+
+	for _, indicators := range basicIndicators {
+		if indicators.WaistSize != nil && *indicators.WaistSize > 0 {
+			data.WaistSize = indicators.WaistSize
+			break
+		}
+	}
+
+	for _, analysis := range analyses {
+		if analysis.Triglycerides != nil && data.Triglycerides == nil {
+			data.Triglycerides = analysis.Triglycerides
+		}
+		if analysis.HighDensityCholesterol != nil && *analysis.HighDensityCholesterol > 0 && data.HDLCholesterol == nil {
+			data.HDLCholesterol = analysis.HighDensityCholesterol
+		}
+
+		// fastest break condition
+		if data.Triglycerides != nil && data.HDLCholesterol != nil {
+			break
+		}
+	}
+
+	return data
+}
+
+// MetabolicSyndromeCriterion represents the result of the metabolic syndrome criterion evaluation.
+type MetabolicSyndromeCriterion struct {
+	Code      string `json:"code"`
+	Name      string `json:"name"`
+	Threshold string `json:"threshold"`
+	Status    string `json:"status"`
+	// Values are the values the criterion is evaluated by, named as the missing data
+	Values map[string]float64 `json:"values,omitempty"`
+	// ByDiagnosis is true if the criterion is met by the diagnosed hypertension or diabetes
+	ByDiagnosis bool `json:"byDiagnosis"`
+	// MissingData is the data needed to evaluate the criterion, see MetabolicSyndromeDataNames
+	MissingData []string `json:"missingData,omitempty"`
+}
+
+// MetabolicSyndromeDefinitionResult represents the metabolic syndrome screening result by one of the definitions.
+type MetabolicSyndromeDefinitionResult struct {
+	Definition string                        `json:"definition"`
+	Name       string                        `json:"name"`
+	Status     string                        `json:"status"`
+	MetCount   int                           `json:"metCount"`
+	Criteria   []*MetabolicSyndromeCriterion `json:"criteria"`
+}
+
+// MetabolicSyndromeScreening represents the metabolic syndrome screening result of the evaluation date.
+type MetabolicSyndromeScreening struct {
+	ID             uint64     `json:"id,omitempty"`
+	UserID         uint64     `json:"-"`
+	EvaluationDate model.Date `json:"evaluationDate"`
+	// Status is present if the syndrome is present by any of the definitions and absent if it is absent by all of them
+	Status      string                               `json:"status"`
+	Definitions []*MetabolicSyndromeDefinitionResult `json:"definitions"`
+	// MissingData is the data still needed to evaluate all the criteria, see MetabolicSyndromeDataNames
+	MissingData []string `json:"missingData"`
+}
+
+// Definition returns the result by the definition, nil if there is no such result.
+func (s *MetabolicSyndromeScreening) Definition(definition string) *MetabolicSyndromeDefinitionResult {
+	for _, result := range s.Definitions {
+		if result.Definition == definition {
+			return result
+		}
+	}
+	return nil
+}
+
+// MetCriteriaToString lists the names of the criteria met by any of the definitions.
+func (s *MetabolicSyndromeScreening) MetCriteriaToString() string {
+	met := make(map[string]bool, len(metabolicSyndromeCriteriaOrder))
+	for _, result := range s.Definitions {
+		for _, criterion := range result.Criteria {
+			if criterion.Status == common.MetabolicSyndromeCriterionMet {
+				met[criterion.Code] = true
+			}
+		}
+	}
+
+	names := make([]string, 0, len(met))
+	for _, code := range metabolicSyndromeCriteriaOrder {
+		if met[code] {
+			// the names are listed in the middle of the sentence
+			name := []rune(metabolicSyndromeCriterionNames[code])
+			names = append(names, string(unicode.ToLower(name[0]))+string(name[1:]))
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// MetCriteriaCount returns the number of the criteria met by any of the definitions.
+func (s *MetabolicSyndromeScreening) MetCriteriaCount() int {
+	var count int
+	for _, result := range s.Definitions {
+		if result.MetCount > count {
+			count = result.MetCount
+		}
+	}
+	return count
+}
+
+// MissingDataToString lists the names of the missing data.
+func (s *MetabolicSyndromeScreening) MissingDataToString() string {
+	names := make([]string, 0, len(s.MissingData))
+	for _, data := range s.MissingData {
+		names = append(names, MetabolicSyndromeDataNames[data])
+	}
+	return strings.Join(names, ", ")
+}
+
+// ScreenMetabolicSyndrome evaluates the metabolic syndrome criteria by the NCEP ATP III definition (three of the five
+// criteria) and by the IDF one (the central obesity and two of the other four criteria). The result is indeterminate
+// if the missing data may change it. The glucose level is assumed to be measured on an empty stomach.
+func ScreenMetabolicSyndrome(data MetabolicSyndromeData) *MetabolicSyndromeScreening {
+	triglycerides := data.triglyceridesCriterion()
+	hdlCholesterol := data.hdlCholesterolCriterion()
+	bloodPressure := data.bloodPressureCriterion()
+	glucose := data.glucoseCriterion()
+
+	ncep := newMetabolicSyndromeDefinitionResult(common.MetabolicSyndromeDefinitionNCEP,
+		data.waistSizeCriterion(ncepWaistSizeThreshold, "более 102 см у мужчин, более 88 см у женщин"),
+		triglycerides, hdlCholesterol, bloodPressure, glucose,
+	)
+	ncep.Status = metabolicSyndromeStatus(ncep.MetCount, countMissingCriteria(ncep.Criteria), metabolicSyndromeNCEPCriteriaRequired)
+
+	idfWaistSize := data.waistSizeCriterion(idfWaistSizeThreshold, "94 см и более у мужчин, 80 см и более у женщин")
+	idf := newMetabolicSyndromeDefinitionResult(common.MetabolicSyndromeDefinitionIDF,
+		idfWaistSize, triglycerides, hdlCholesterol, bloodPressure, glucose,
+	)
+
+	// the central obesity is mandatory, the other criteria are counted without it
+	otherMet, otherMissing := idf.MetCount, countMissingCriteria(idf.Criteria[1:])
+	if idfWaistSize.Status == common.MetabolicSyndromeCriterionMet {
+		otherMet--
+	}
+	switch idfWaistSize.Status {
+	case common.MetabolicSyndromeCriterionNotMet:
+		idf.Status = common.MetabolicSyndromeAbsent
+	case common.MetabolicSyndromeCriterionMet:
+		idf.Status = metabolicSyndromeStatus(otherMet, otherMissing, metabolicSyndromeIDFCriteriaRequired)
+	default:
+		if otherMet+otherMissing < metabolicSyndromeIDFCriteriaRequired {
+			idf.Status = common.MetabolicSyndromeAbsent
+		} else {
+			idf.Status = common.MetabolicSyndromeIndeterminate
+		}
+	}
+
+	screening := &MetabolicSyndromeScreening{
+		Definitions: []*MetabolicSyndromeDefinitionResult{ncep, idf},
+		MissingData: make([]string, 0, len(metabolicSyndromeDataOrder)),
+	}
+
+	switch {
+	case ncep.Status == common.MetabolicSyndromePresent || idf.Status == common.MetabolicSyndromePresent:
+		screening.Status = common.MetabolicSyndromePresent
+	case ncep.Status == common.MetabolicSyndromeAbsent && idf.Status == common.MetabolicSyndromeAbsent:
+		screening.Status = common.MetabolicSyndromeAbsent
+	default:
+		screening.Status = common.MetabolicSyndromeIndeterminate
+	}
+
+	missingData := make(map[string]bool, len(metabolicSyndromeDataOrder))
+	for _, result := range screening.Definitions {
+		for _, criterion := range result.Criteria {
+			for _, name := range criterion.MissingData {
+				missingData[name] = true
+			}
+		}
+	}
+	for _, name := range metabolicSyndromeDataOrder {
+		if missingData[name] {
+			screening.MissingData = append(screening.MissingData, name)
+		}
+	}
+
+	return screening
+}
+
+func newMetabolicSyndromeDefinitionResult(definition string, criteria ...*MetabolicSyndromeCriterion) *MetabolicSyndromeDefinitionResult {
+	result := &MetabolicSyndromeDefinitionResult{
+		Definition: definition,
+		Name:       metabolicSyndromeDefinitionNames[definition],
+		Criteria:   criteria,
+	}
+	for _, criterion := range criteria {
+		if criterion.Status == common.MetabolicSyndromeCriterionMet {
+			result.MetCount++
+		}
+	}
+	return result
+}
+
+// metabolicSyndromeStatus returns the status by the number of the met and the missing criteria: the syndrome is
+// present if the required number of the criteria is met and absent if it can not be met even by the missing ones.
+func metabolicSyndromeStatus(met, missing, required int) string {
+	switch {
+	case met >= required:
+		return common.MetabolicSyndromePresent
+	case met+missing < required:
+		return common.MetabolicSyndromeAbsent
+	default:
+		return common.MetabolicSyndromeIndeterminate
+	}
+}
+
+func countMissingCriteria(criteria []*MetabolicSyndromeCriterion) int {
+	var missing int
+	for _, criterion := range criteria {
+		if criterion.Status == common.MetabolicSyndromeCriterionMissing {
+			missing++
+		}
+	}
+	return missing
+}
+
+func newMetabolicSyndromeCriterion(code, threshold string) *MetabolicSyndromeCriterion {
+	return &MetabolicSyndromeCriterion{
+		Code:      code,
+		Name:      metabolicSyndromeCriterionNames[code],
+		Threshold: threshold,
+		Status:    common.MetabolicSyndromeCriterionMissing,
+		Values:    make(map[string]float64, 2),
+	}
+}
+
+func (d MetabolicSyndromeData) waistSizeCriterion(threshold sexSpecificThreshold, description string) *MetabolicSyndromeCriterion {
+	criterion := newMetabolicSyndromeCriterion(common.MetabolicSyndromeCriterionWaistSize, description)
+	if d.WaistSize == nil {
+		criterion.MissingData = []string{common.MetabolicSyndromeDataWaistSize}
+		return criterion
+	}
+	criterion.Values[common.MetabolicSyndromeDataWaistSize] = *d.WaistSize
+
+	var genderNeeded bool
+	if criterion.Status, genderNeeded = threshold.status(*d.WaistSize, d.Gender); genderNeeded {
+		criterion.MissingData = []string{common.MetabolicSyndromeDataGender}
+	}
+	return criterion
+}
+
+func (d MetabolicSyndromeData) triglyceridesCriterion() *MetabolicSyndromeCriterion {
+	criterion := newMetabolicSyndromeCriterion(common.MetabolicSyndromeCriterionTriglycerides, "1,7 ммоль/л и более")
+	if d.Triglycerides == nil {
+		criterion.MissingData = []string{common.MetabolicSyndromeDataTriglycerides}
+		return criterion
+	}
+	criterion.Values[common.MetabolicSyndromeDataTriglycerides] = *d.Triglycerides
+
+	if *d.Triglycerides >= metabolicSyndromeTriglyceridesLevel {
+		criterion.Status = common.MetabolicSyndromeCriterionMet
+	} else {
+		criterion.Status = common.MetabolicSyndromeCriterionNotMet
+	}
+	return criterion
+}
+
+func (d MetabolicSyndromeData) hdlCholesterolCriterion() *MetabolicSyndromeCriterion {
+	criterion := newMetabolicSyndromeCriterion(common.MetabolicSyndromeCriterionHDLCholesterol,
+		"менее 1,03 ммоль/л у мужчин, менее 1,29 ммоль/л у женщин")
+	if d.HDLCholesterol == nil {
+		criterion.MissingData = []string{common.MetabolicSyndromeDataHDLCholesterol}
+		return criterion
+	}
+	criterion.Values[common.MetabolicSyndromeDataHDLCholesterol] = *d.HDLCholesterol
+
+	var genderNeeded bool
+	if criterion.Status, genderNeeded = hdlCholesterolThreshold.status(*d.HDLCholesterol, d.Gender); genderNeeded {
+		criterion.MissingData = []string{common.MetabolicSyndromeDataGender}
+	}
+	return criterion
+}
+
+func (d MetabolicSyndromeData) bloodPressureCriterion() *MetabolicSyndromeCriterion {
+	criterion := newMetabolicSyndromeCriterion(common.MetabolicSyndromeCriterionBloodPressure,
+		"130/85 мм рт. ст. и более или диагностированная артериальная гипертензия")
+	if d.SBPLevel != nil {
+		criterion.Values[common.MetabolicSyndromeDataSBPLevel] = *d.SBPLevel
+	}
+	if d.DBPLevel != nil {
+		criterion.Values[common.MetabolicSyndromeDataDBPLevel] = *d.DBPLevel
+	}
+
+	switch {
+	case d.HasArterialHypertension:
+		criterion.Status = common.MetabolicSyndromeCriterionMet
+		criterion.ByDiagnosis = true
+	case d.SBPLevel != nil && *d.SBPLevel >= metabolicSyndromeSBPLevel,
+		d.DBPLevel != nil && *d.DBPLevel >= metabolicSyndromeDBPLevel:
+		criterion.Status = common.MetabolicSyndromeCriterionMet
+	case d.SBPLevel != nil && d.DBPLevel != nil:
+		criterion.Status = common.MetabolicSyndromeCriterionNotMet
+	default:
+		if d.SBPLevel == nil {
+			criterion.MissingData = append(criterion.MissingData, common.MetabolicSyndromeDataSBPLevel)
+		}
+		if d.DBPLevel == nil {
+			criterion.MissingData = append(criterion.MissingData, common.MetabolicSyndromeDataDBPLevel)
+		}
+	}
+	return criterion
+}
+
+func (d MetabolicSyndromeData) glucoseCriterion() *MetabolicSyndromeCriterion {
+	criterion := newMetabolicSyndromeCriterion(common.MetabolicSyndromeCriterionGlucose,
+		"5,6 ммоль/л и более или диагностированный сахарный диабет 2 типа")
+	if d.Glucose != nil {
+		criterion.Values[common.MetabolicSyndromeDataGlucose] = *d.Glucose
+	}
+
+	switch {
+	case d.HasTypeTwoDiabetes:
+		criterion.Status = common.MetabolicSyndromeCriterionMet
+		criterion.ByDiagnosis = true
+	case d.Glucose == nil:
+		criterion.MissingData = []string{common.MetabolicSyndromeDataGlucose}
+	case *d.Glucose >= metabolicSyndromeGlucoseLevel:
+		criterion.Status = common.MetabolicSyndromeCriterionMet
+	default:
+		criterion.Status = common.MetabolicSyndromeCriterionNotMet
+	}
+	return criterion
+}
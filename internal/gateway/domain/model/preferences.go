@@ -0,0 +1,45 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+)
+
+var ErrInvalidUnitSystem = errors.New("invalid unitSystem value")
+
+// Preferences represents the user settings which are not the part of the user profile stored by the auth service.
+type Preferences struct {
+	UserID     uint64 `json:"-" db:"user_id"`
+	UnitSystem string `json:"unitSystem" db:"unit_system"`
+}
+
+func (p Preferences) Validate() error {
+	err := validation.ValidateStruct(&p,
+		validation.Field(&p.UserID, validation.Required),
+		validation.Field(&p.UnitSystem, validation.Required, validation.In(common.UnitSystemSI, common.UnitSystemUS)),
+	)
+	if err != nil {
+		var errBytes []byte
+		errBytes, err = json.Marshal(err)
+		if err != nil {
+			return err
+		}
+
+		var validationErrors map[string]string
+		if err = json.Unmarshal(errBytes, &validationErrors); err != nil {
+			return err
+		}
+
+		if validationError, found := validationErrors["unitSystem"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidUnitSystem, validationError)
+		}
+
+		return errors.New("invalid preferences data")
+	}
+	return nil
+}
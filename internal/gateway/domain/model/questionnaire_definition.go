@@ -0,0 +1,326 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+var (
+	ErrQuestionnaireNotFound       = errors.New("questionnaire with this code not found")
+	ErrInvalidQuestionnaire        = errors.New("invalid questionnaire definition")
+	ErrInvalidQuestionnaireAnswers = errors.New("invalid questionnaire answers")
+)
+
+// QuestionnaireDefinition represents the questionnaire (test) defined declaratively in the config. The Version must
+// be increased on every change of the questions or the scoring, so the submissions remember the definition they
+// were scored by.
+type QuestionnaireDefinition struct {
+	Code        string                   `json:"code" yaml:"code"`
+	Name        string                   `json:"name" yaml:"name"`
+	Description string                   `json:"description,omitempty" yaml:"description"`
+	Version     int                      `json:"version" yaml:"version"`
+	Questions   []*QuestionnaireQuestion `json:"questions" yaml:"questions"`
+	Scales      []*QuestionnaireScale    `json:"scales" yaml:"scales"`
+}
+
+// QuestionnaireQuestion represents the question of the questionnaire. The question is asked only if all its ShowIf
+// conditions are satisfied by the answers to the previous questions.
+type QuestionnaireQuestion struct {
+	Code     string                    `json:"code" yaml:"code"`
+	Text     string                    `json:"text" yaml:"text"`
+	Type     string                    `json:"type" yaml:"type"`
+	Options  []*QuestionnaireOption    `json:"options" yaml:"options"`
+	Optional bool                      `json:"optional" yaml:"optional"`
+	ShowIf   []*QuestionnaireCondition `json:"showIf,omitempty" yaml:"show_if"`
+}
+
+// QuestionnaireOption represents the answer option of the question.
+type QuestionnaireOption struct {
+	Value string  `json:"value" yaml:"value"`
+	Text  string  `json:"text" yaml:"text"`
+	Score float64 `json:"score" yaml:"score"`
+}
+
+// QuestionnaireCondition is satisfied if any of the In options is chosen in the answer to the Question.
+type QuestionnaireCondition struct {
+	Question string   `json:"question" yaml:"question"`
+	In       []string `json:"in" yaml:"in"`
+}
+
+// QuestionnaireScale represents the scoring of the questionnaire: the scores of the chosen options of the Questions
+// (all questions if empty) are aggregated by the Method and the result is interpreted by the first matching band.
+type QuestionnaireScale struct {
+	Code      string               `json:"code" yaml:"code"`
+	Name      string               `json:"name" yaml:"name"`
+	Method    string               `json:"method" yaml:"method"`
+	Questions []string             `json:"questions,omitempty" yaml:"questions"`
+	Bands     []*QuestionnaireBand `json:"bands" yaml:"bands"`
+}
+
+// QuestionnaireBand describes the interpretation of the scale values in [Min, Max) range. Nil bounds mean no
+// restrictions.
+type QuestionnaireBand struct {
+	Code string   `json:"code" yaml:"code"`
+	Text string   `json:"text" yaml:"text"`
+	Min  *float64 `json:"min,omitempty" yaml:"min"`
+	Max  *float64 `json:"max,omitempty" yaml:"max"`
+}
+
+// Validate checks the consistency of the definition: the codes are unique, the conditions refer to the options of
+// the previous questions and the scales refer to the existing questions.
+func (d *QuestionnaireDefinition) Validate() error {
+	if d.Code == "" || d.Name == "" || d.Version <= 0 || len(d.Questions) == 0 {
+		return fmt.Errorf("%w: %v: code, name, positive version and questions are required", ErrInvalidQuestionnaire, d.Code)
+	}
+
+	options := make(map[string]map[string]bool, len(d.Questions))
+	for _, question := range d.Questions {
+		if question.Code == "" || question.Text == "" {
+			return fmt.Errorf("%w: %v: question code and text are required", ErrInvalidQuestionnaire, d.Code)
+		}
+		if _, found := options[question.Code]; found {
+			return fmt.Errorf("%w: %v: duplicate question %v", ErrInvalidQuestionnaire, d.Code, question.Code)
+		}
+		if question.Type != common.QuestionTypeSingle && question.Type != common.QuestionTypeMultiple {
+			return fmt.Errorf("%w: %v: question %v: unknown type %q", ErrInvalidQuestionnaire, d.Code, question.Code, question.Type)
+		}
+		if len(question.Options) == 0 {
+			return fmt.Errorf("%w: %v: question %v: options are required", ErrInvalidQuestionnaire, d.Code, question.Code)
+		}
+
+		for _, condition := range question.ShowIf {
+			conditionOptions, found := options[condition.Question]
+			if !found {
+				return fmt.Errorf("%w: %v: question %v: condition refers to unknown or next question %v", ErrInvalidQuestionnaire, d.Code, question.Code, condition.Question)
+			}
+			for _, value := range condition.In {
+				if !conditionOptions[value] {
+					return fmt.Errorf("%w: %v: question %v: condition refers to unknown option %v", ErrInvalidQuestionnaire, d.Code, question.Code, value)
+				}
+			}
+		}
+
+		questionOptions := make(map[string]bool, len(question.Options))
+		for _, option := range question.Options {
+			if option.Value == "" || questionOptions[option.Value] {
+				return fmt.Errorf("%w: %v: question %v: option values must be unique and not empty", ErrInvalidQuestionnaire, d.Code, question.Code)
+			}
+			questionOptions[option.Value] = true
+		}
+		options[question.Code] = questionOptions
+	}
+
+	scales := make(map[string]bool, len(d.Scales))
+	for _, scale := range d.Scales {
+		if scale.Code == "" || scales[scale.Code] {
+			return fmt.Errorf("%w: %v: scale codes must be unique and not empty", ErrInvalidQuestionnaire, d.Code)
+		}
+		scales[scale.Code] = true
+
+		if scale.Method != common.ScaleMethodSum && scale.Method != common.ScaleMethodMean {
+			return fmt.Errorf("%w: %v: scale %v: unknown method %q", ErrInvalidQuestionnaire, d.Code, scale.Code, scale.Method)
+		}
+		for _, questionCode := range scale.Questions {
+			if _, found := options[questionCode]; !found {
+				return fmt.Errorf("%w: %v: scale %v: unknown question %v", ErrInvalidQuestionnaire, d.Code, scale.Code, questionCode)
+			}
+		}
+	}
+
+	return nil
+}
+
+// QuestionnaireAnswers represents the chosen options values keyed by the question code.
+type QuestionnaireAnswers map[string]QuestionnaireAnswer
+
+// QuestionnaireAnswer represents the chosen options values of the question. It is unmarshalled from both the single
+// value and the array of values.
+type QuestionnaireAnswer []string
+
+func (a *QuestionnaireAnswer) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		*a = QuestionnaireAnswer{value}
+		return nil
+	}
+
+	var values []string
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	*a = values
+	return nil
+}
+
+// Evaluate validates the answers against the definition and calculates the scales. The answers to the questions
+// which are not asked because of their conditions must be omitted.
+func (d *QuestionnaireDefinition) Evaluate(answers QuestionnaireAnswers) ([]*QuestionnaireScaleResult, error) {
+	scores := make(map[string]float64, len(answers))
+	for _, question := range d.Questions {
+		answer, answered := answers[question.Code]
+		answered = answered && len(answer) > 0
+
+		if !d.asked(question, answers) {
+			if answered {
+				return nil, fmt.Errorf("%w: %v: the question is not asked", ErrInvalidQuestionnaireAnswers, question.Code)
+			}
+			continue
+		}
+
+		if !answered {
+			if question.Optional {
+				continue
+			}
+			return nil, fmt.Errorf("%w: %v: the answer is required", ErrInvalidQuestionnaireAnswers, question.Code)
+		}
+
+		if question.Type == common.QuestionTypeSingle && len(answer) != 1 {
+			return nil, fmt.Errorf("%w: %v: exactly one option must be chosen", ErrInvalidQuestionnaireAnswers, question.Code)
+		}
+
+		chosen := make(map[string]bool, len(answer))
+		for _, value := range answer {
+			option := question.option(value)
+			if option == nil {
+				return nil, fmt.Errorf("%w: %v: unknown option %q", ErrInvalidQuestionnaireAnswers, question.Code, value)
+			}
+			if chosen[value] {
+				return nil, fmt.Errorf("%w: %v: option %q is chosen twice", ErrInvalidQuestionnaireAnswers, question.Code, value)
+			}
+			chosen[value] = true
+
+			scores[question.Code] += option.Score
+		}
+	}
+
+	for questionCode := range answers {
+		if d.question(questionCode) == nil {
+			return nil, fmt.Errorf("%w: %v: unknown question", ErrInvalidQuestionnaireAnswers, questionCode)
+		}
+	}
+
+	results := make([]*QuestionnaireScaleResult, 0, len(d.Scales))
+	for _, scale := range d.Scales {
+		results = append(results, scale.evaluate(d, scores))
+	}
+
+	return results, nil
+}
+
+// asked returns true if all conditions of the question are satisfied by the answers.
+func (d *QuestionnaireDefinition) asked(question *QuestionnaireQuestion, answers QuestionnaireAnswers) bool {
+	for _, condition := range question.ShowIf {
+		satisfied := false
+		for _, value := range answers[condition.Question] {
+			for _, expected := range condition.In {
+				if value == expected {
+					satisfied = true
+				}
+			}
+		}
+
+		if !satisfied {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *QuestionnaireDefinition) question(code string) *QuestionnaireQuestion {
+	for _, question := range d.Questions {
+		if question.Code == code {
+			return question
+		}
+	}
+	return nil
+}
+
+func (q *QuestionnaireQuestion) option(value string) *QuestionnaireOption {
+	for _, option := range q.Options {
+		if option.Value == value {
+			return option
+		}
+	}
+	return nil
+}
+
+// QuestionnaireScaleResult represents the value of the questionnaire scale and its interpretation.
+type QuestionnaireScaleResult struct {
+	Scale string `json:"scale"`
+	Name  string `json:"name"`
+	// Value is nil if none of the scale questions is answered
+	Value *float64 `json:"value"`
+	Band  string   `json:"band,omitempty"`
+	Text  string   `json:"text,omitempty"`
+}
+
+// evaluate aggregates the scores of the answered questions of the scale keyed by the question code.
+func (s *QuestionnaireScale) evaluate(definition *QuestionnaireDefinition, scores map[string]float64) *QuestionnaireScaleResult {
+	result := &QuestionnaireScaleResult{
+		Scale: s.Code,
+		Name:  s.Name,
+	}
+
+	questions := s.Questions
+	if len(questions) == 0 {
+		questions = make([]string, 0, len(definition.Questions))
+		for _, question := range definition.Questions {
+			questions = append(questions, question.Code)
+		}
+	}
+
+	var (
+		sum      float64
+		answered int
+	)
+	for _, questionCode := range questions {
+		score, found := scores[questionCode]
+		if !found {
+			continue
+		}
+		sum += score
+		answered++
+	}
+
+	if answered == 0 {
+		return result
+	}
+
+	value := sum
+	if s.Method == common.ScaleMethodMean {
+		value = sum / float64(answered)
+	}
+	value = roundTo(value, 2)
+	result.Value = &value
+
+	for _, band := range s.Bands {
+		if band.Min != nil && value < *band.Min {
+			continue
+		}
+		if band.Max != nil && value >= *band.Max {
+			continue
+		}
+		result.Band = band.Code
+		result.Text = band.Text
+		break
+	}
+
+	return result
+}
+
+// QuestionnaireSubmission represents the answers of the user to the questionnaire and the scales calculated by the
+// Version of the questionnaire definition.
+type QuestionnaireSubmission struct {
+	ID        uint64                      `json:"id,omitempty" db:"id"`
+	UserID    uint64                      `json:"-" db:"user_id"`
+	Code      string                      `json:"code" db:"questionnaire_code"`
+	Version   int                         `json:"version" db:"questionnaire_version"`
+	Answers   QuestionnaireAnswers        `json:"answers" db:"answers"`
+	Results   []*QuestionnaireScaleResult `json:"results" db:"results"`
+	CreatedAt model.Datetime              `json:"createdAt" db:"created_at"`
+}
@@ -0,0 +1,636 @@
+package model
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var ErrInvalidRecommendationRule = errors.New("invalid recommendation rule")
+
+// modifiable risk factors names used in the recommendations
+var riskFactorNames = map[string]string{
+	common.RiskFactorSmoking:     "курение",
+	common.RiskFactorSBPLevel:    "повышенное систолическое артериальное давление",
+	common.RiskFactorCholesterol: "повышенный уровень холестерина",
+}
+
+// recommendationTemplateFuncs are the functions available in the recommendation texts templates.
+var recommendationTemplateFuncs = template.FuncMap{
+	"years": func(years float64) string {
+		return yearsToString(int(years))
+	},
+}
+
+// RecommendationRule represents the recommendation defined declaratively in the config: the recommendation is given
+// if the condition is satisfied by the patient facts, its texts are the templates executed on the facts.
+type RecommendationRule struct {
+	Code     string `yaml:"code"`
+	Category string `yaml:"category"`
+	// Priority orders the recommendations with the same expected risk reduction, the higher one goes first
+	Priority int `yaml:"priority"`
+	// RiskReduction is the name of the fact holding the expected risk reduction if the recommendation is followed
+	RiskReduction string                   `yaml:"risk_reduction"`
+	When          *RecommendationCondition `yaml:"when"`
+	What          string                   `yaml:"what"`
+	Why           string                   `yaml:"why"`
+	How           string                   `yaml:"how"`
+
+	// parsed by Validate
+	whatTemplate *template.Template
+	whyTemplate  *template.Template
+	howTemplate  *template.Template
+}
+
+// RecommendationCondition is either the group of the conditions (All, Any or Not) or the comparison of the Fact with
+// the Value by the Operator. The comparisons of the unknown facts are not satisfied, except for the "exists" one.
+type RecommendationCondition struct {
+	All []*RecommendationCondition `yaml:"all"`
+	Any []*RecommendationCondition `yaml:"any"`
+	Not *RecommendationCondition   `yaml:"not"`
+
+	Fact     string      `yaml:"fact"`
+	Operator string      `yaml:"op"`
+	Value    interface{} `yaml:"value"` // the list of the values for the "in" operator, optional bool for "exists"
+}
+
+// Validate checks the rule condition and parses the texts templates.
+func (r *RecommendationRule) Validate() error {
+	if r.Code == "" || r.What == "" {
+		return fmt.Errorf("%w: code and what are required", ErrInvalidRecommendationRule)
+	}
+	if err := validation.Validate(r.Category, validation.Required, validation.In(
+		common.RecommendationCategoryBehaviour,
+		common.RecommendationCategoryBloodPressure,
+		common.RecommendationCategoryLipids,
+		common.RecommendationCategoryBodyWeight,
+		common.RecommendationCategoryRiskAssessment,
+		common.RecommendationCategoryInflammation,
+		common.RecommendationCategoryKidney,
+		common.RecommendationCategoryMetabolic,
+		common.RecommendationCategoryDiabetes,
+	)); err != nil {
+		return fmt.Errorf("%w: %v: category: %v", ErrInvalidRecommendationRule, r.Code, err)
+	}
+	if r.When == nil {
+		return fmt.Errorf("%w: %v: condition is required", ErrInvalidRecommendationRule, r.Code)
+	}
+	if err := r.When.validate(); err != nil {
+		return fmt.Errorf("%w: %v: %v", ErrInvalidRecommendationRule, r.Code, err)
+	}
+
+	var err error
+	for _, text := range []struct {
+		template **template.Template
+		text     string
+		name     string
+	}{
+		{template: &r.whatTemplate, text: r.What, name: "what"},
+		{template: &r.whyTemplate, text: r.Why, name: "why"},
+		{template: &r.howTemplate, text: r.How, name: "how"},
+	} {
+		*text.template, err = template.New(r.Code + "_" + text.name).Funcs(recommendationTemplateFuncs).Parse(text.text)
+		if err != nil {
+			return fmt.Errorf("%w: %v: %v", ErrInvalidRecommendationRule, r.Code, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *RecommendationCondition) validate() error {
+	groups := 0
+	if c.All != nil {
+		groups++
+	}
+	if c.Any != nil {
+		groups++
+	}
+	if c.Not != nil {
+		groups++
+	}
+
+	if groups > 0 {
+		if groups > 1 || c.Fact != "" {
+			return errors.New("condition must be either all, any, not or the comparison")
+		}
+
+		for _, condition := range append(append([]*RecommendationCondition{c.Not}, c.All...), c.Any...) {
+			if condition == nil {
+				continue
+			}
+			if err := condition.validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if c.Fact == "" {
+		return errors.New("condition fact is required")
+	}
+
+	switch c.Operator {
+	case common.ConditionOperatorEq, common.ConditionOperatorNe:
+		if _, ok := comparableValue(c.Value); !ok {
+			return fmt.Errorf("%v: value must be number, string or bool", c.Fact)
+		}
+	case common.ConditionOperatorGt, common.ConditionOperatorGte, common.ConditionOperatorLt, common.ConditionOperatorLte:
+		if _, ok := numberValue(c.Value); !ok {
+			return fmt.Errorf("%v: value must be number", c.Fact)
+		}
+	case common.ConditionOperatorIn:
+		values, ok := c.Value.([]interface{})
+		if !ok || len(values) == 0 {
+			return fmt.Errorf("%v: value must be not empty list", c.Fact)
+		}
+		for _, value := range values {
+			if _, ok = comparableValue(value); !ok {
+				return fmt.Errorf("%v: values must be numbers, strings or bools", c.Fact)
+			}
+		}
+	case common.ConditionOperatorExists:
+		if _, ok := c.Value.(bool); c.Value != nil && !ok {
+			return fmt.Errorf("%v: value must be bool", c.Fact)
+		}
+	default:
+		return fmt.Errorf("%v: unknown operator %q", c.Fact, c.Operator)
+	}
+
+	return nil
+}
+
+// Satisfied evaluates the condition on the facts.
+func (c *RecommendationCondition) Satisfied(facts RecommendationFacts) bool {
+	switch {
+	case c.All != nil:
+		for _, condition := range c.All {
+			if !condition.Satisfied(facts) {
+				return false
+			}
+		}
+		return true
+	case c.Any != nil:
+		for _, condition := range c.Any {
+			if condition.Satisfied(facts) {
+				return true
+			}
+		}
+		return false
+	case c.Not != nil:
+		return !c.Not.Satisfied(facts)
+	}
+
+	fact, known := facts[c.Fact]
+
+	if c.Operator == common.ConditionOperatorExists {
+		expected, ok := c.Value.(bool)
+		if !ok {
+			expected = true
+		}
+		return known == expected
+	}
+
+	if !known {
+		return false
+	}
+
+	switch c.Operator {
+	case common.ConditionOperatorEq:
+		return valuesEqual(fact, c.Value)
+	case common.ConditionOperatorNe:
+		return !valuesEqual(fact, c.Value)
+	case common.ConditionOperatorIn:
+		values, _ := c.Value.([]interface{})
+		for _, value := range values {
+			if valuesEqual(fact, value) {
+				return true
+			}
+		}
+		return false
+	}
+
+	factNumber, ok := numberValue(fact)
+	if !ok {
+		return false
+	}
+	value, _ := numberValue(c.Value)
+
+	switch c.Operator {
+	case common.ConditionOperatorGt:
+		return factNumber > value
+	case common.ConditionOperatorGte:
+		return factNumber >= value
+	case common.ConditionOperatorLt:
+		return factNumber < value
+	case common.ConditionOperatorLte:
+		return factNumber <= value
+	default:
+		return false
+	}
+}
+
+// comparableValue normalizes the numbers to float64, so the values parsed from the config are comparable with the
+// facts.
+func comparableValue(value interface{}) (interface{}, bool) {
+	switch typedValue := value.(type) {
+	case string, bool:
+		return typedValue, true
+	default:
+		return numberValue(value)
+	}
+}
+
+func numberValue(value interface{}) (float64, bool) {
+	switch typedValue := value.(type) {
+	case float64:
+		return typedValue, true
+	case int:
+		return float64(typedValue), true
+	case int64:
+		return float64(typedValue), true
+	case uint64:
+		return float64(typedValue), true
+	default:
+		return 0, false
+	}
+}
+
+func valuesEqual(a, b interface{}) bool {
+	aValue, ok := comparableValue(a)
+	if !ok {
+		return false
+	}
+	bValue, ok := comparableValue(b)
+	if !ok {
+		return false
+	}
+	return aValue == bValue
+}
+
+// RecommendationFacts represents the patient data the recommendation rules are evaluated on keyed by the fact name.
+// The values are float64, bool or string, the unknown facts are absent.
+type RecommendationFacts map[string]interface{}
+
+// EvaluateRecommendationRules returns the recommendations of the rules satisfied by the facts ranked by the expected
+// risk reduction, then by the rules priorities and codes, so the order is the same for the same facts.
+func EvaluateRecommendationRules(rules []*RecommendationRule, facts RecommendationFacts) ([]*Recommendation, error) {
+	recommendations := make([]*Recommendation, 0, len(rules))
+	priorities := make(map[*Recommendation]int, len(rules))
+	for _, rule := range rules {
+		if !rule.When.Satisfied(facts) {
+			continue
+		}
+
+		recommendation, err := rule.recommendation(facts)
+		if err != nil {
+			return nil, err
+		}
+		recommendations = append(recommendations, recommendation)
+		priorities[recommendation] = rule.Priority
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		a, b := recommendations[i], recommendations[j]
+
+		var aReduction, bReduction float64
+		if a.RiskReduction != nil {
+			aReduction = *a.RiskReduction
+		}
+		if b.RiskReduction != nil {
+			bReduction = *b.RiskReduction
+		}
+
+		switch {
+		case aReduction != bReduction:
+			return aReduction > bReduction
+		case priorities[a] != priorities[b]:
+			return priorities[a] > priorities[b]
+		default:
+			return a.Code < b.Code
+		}
+	})
+
+	for i, recommendation := range recommendations {
+		recommendation.Priority = i + 1
+	}
+
+	return recommendations, nil
+}
+
+func (r *RecommendationRule) recommendation(facts RecommendationFacts) (*Recommendation, error) {
+	if r.whatTemplate == nil {
+		if err := r.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	recommendation := Recommendation{
+		Code:     r.Code,
+		Category: r.Category,
+	}
+	if reduction, ok := numberValue(facts[r.RiskReduction]); ok && reduction > 0 {
+		recommendation.RiskReduction = &reduction
+	}
+
+	for _, text := range []struct {
+		template *template.Template
+		value    *string
+	}{
+		{template: r.whatTemplate, value: &recommendation.What},
+		{template: r.whyTemplate, value: &recommendation.Why},
+		{template: r.howTemplate, value: &recommendation.How},
+	} {
+		buffer := &bytes.Buffer{}
+		if err := text.template.Execute(buffer, map[string]interface{}(facts)); err != nil {
+			return nil, fmt.Errorf("recommendation %v: %w", r.Code, err)
+		}
+		*text.value = buffer.String()
+	}
+
+	return &recommendation, nil
+}
+
+// RecommendationPatient represents the patient data the recommendation facts are derived from, the analyses derived
+// values (see Analysis.Derive) are expected to be calculated.
+type RecommendationPatient struct {
+	// ScoreData contains the latest gender, smoking status, SBP, total and HDL cholesterol levels, the Age is 0 if
+	// unknown
+	ScoreData   ScoreData
+	ScoreRanges ScoreDataRanges
+	// BasicIndicators and Analyses are sorted from the newest to the oldest
+	BasicIndicators []*BasicIndicators
+	Analyses        []*Analysis
+	Diseases        *Diseases
+	Lifestyle       *Lifestyle
+	Questionnaire   *Questionnaire
+	// Risk is nil if the SCORE data is insufficient to calculate the risk
+	Risk *RecommendationRisk
+	// MetabolicSyndrome is the latest metabolic syndrome screening result, nil if unknown
+	MetabolicSyndrome *MetabolicSyndromeScreening
+	// GlucoseMeasurements are the glycaemic diary measurements and lab results sorted from the newest to the oldest, see
+	// MergeGlycaemicLabResults
+	GlucoseMeasurements []*GlucoseMeasurement
+	// ActivityEntries are the recent physical activity log entries, the activity facts are unknown if there are none
+	ActivityEntries []*ActivityEntry
+}
+
+// RecommendationRisk represents the SCORE values calculated for the patient.
+type RecommendationRisk struct {
+	Value float64
+	// ValueNotSmoking is the risk value if the smoker quits, nil for the non-smoker
+	ValueNotSmoking   *float64
+	CardiovascularAge *CardiovascularAge
+	// Approximate is true if HDL cholesterol is unknown
+	Approximate bool
+}
+
+// Facts derives the recommendation facts from the patient data. The SCORE data values out of the supported ranges
+// are considered unknown, the latest known values of the basic indicators and the analyses are used.
+func (p RecommendationPatient) Facts() RecommendationFacts {
+	facts := make(RecommendationFacts)
+
+	data := p.ScoreData
+	if data.Age > 0 {
+		facts[common.FactAge] = float64(data.Age)
+	}
+	if data.Gender == common.UserGenderMale || data.Gender == common.UserGenderFemale {
+		facts[common.FactGender] = data.Gender
+	}
+	facts[common.FactSmoking] = data.Smoking
+	if data.SBPLevel >= p.ScoreRanges.SBPLevelMin && data.SBPLevel <= p.ScoreRanges.SBPLevelMax && data.SBPLevel > 0 {
+		facts[common.FactSBPLevel] = data.SBPLevel
+		category := ClassifyBloodPressure(data.SBPLevel, nil)
+		facts[common.FactSBPCategory] = category
+		facts[common.FactHypertension] = IsHypertension(category)
+	}
+	if data.TotalCholesterolLevel >= p.ScoreRanges.TotalCholesterolLevelMin &&
+		data.TotalCholesterolLevel <= p.ScoreRanges.TotalCholesterolLevelMax && data.TotalCholesterolLevel > 0 {
+		facts[common.FactTotalCholesterolLevel] = data.TotalCholesterolLevel
+	}
+	if data.HDLCholesterolLevel > 0 {
+		facts[common.FactHDLCholesterolLevel] = data.HDLCholesterolLevel
+	}
+
+	p.addBasicIndicatorsFacts(facts)
+
+	// the latest known value of each of the analysis values
+	for i := len(p.Analyses) - 1; i >= 0; i-- {
+		analysis := p.Analyses[i]
+		for valueName, value := range analysis.Values() {
+			facts[valueName] = value
+		}
+		if analysis.AtheroscleroticPlaquesPresence != nil {
+			facts[common.AnalysisValueAtheroscleroticPlaquesPresence] = *analysis.AtheroscleroticPlaquesPresence
+		}
+	}
+
+	if p.Diseases != nil {
+		addRecordFacts(facts, p.Diseases, nil)
+	}
+	if p.Lifestyle != nil {
+		// the lifestyle values are empty until the user fills them
+		addRecordFacts(facts, p.Lifestyle, func(value interface{}) bool {
+			return value != ""
+		})
+	}
+	if p.Questionnaire != nil {
+		// the questionnaire values are negative until the user passes the tests
+		addRecordFacts(facts, p.Questionnaire, func(value interface{}) bool {
+			number, ok := numberValue(value)
+			return ok && number >= 0
+		})
+	}
+
+	if p.Risk != nil {
+		facts[common.FactRiskValue] = p.Risk.Value
+		facts[common.FactRiskApproximate] = p.Risk.Approximate
+		if p.Risk.ValueNotSmoking != nil {
+			facts[common.FactRiskValueNotSmoking] = *p.Risk.ValueNotSmoking
+		}
+		if cardiovascularAge := p.Risk.CardiovascularAge; cardiovascularAge != nil {
+			facts[common.FactCardiovascularAge] = float64(cardiovascularAge.Age)
+			if data.Age > 0 {
+				facts[common.FactCardiovascularAgeDifference] = float64(cardiovascularAge.Age - data.Age)
+			}
+			if riskFactors := riskFactorsToString(cardiovascularAge.Factors); riskFactors != "" {
+				facts[common.FactRiskFactors] = riskFactors
+			}
+			addRiskReductionsFacts(facts, cardiovascularAge)
+		}
+	}
+
+	var riskValue *float64
+	if p.Risk != nil {
+		riskValue = &p.Risk.Value
+	}
+	riskCategory := ClassifyRiskCategory(NewRiskCategoryData(data, riskValue, p.Diseases, p.Analyses))
+	if riskCategory != nil {
+		facts[common.FactRiskCategory] = riskCategory.Category
+		facts[common.FactRiskCategoryName] = riskCategory.Name
+		facts[common.FactRiskCategoryReason] = riskCategory.Reason
+
+		var takesStatins bool
+		if p.Diseases != nil {
+			takesStatins = p.Diseases.TakesStatins
+		}
+
+		lipidManagement := EvaluateLipidManagement(riskCategory, p.Analyses, takesStatins)
+		facts[common.FactLDLCholesterolGoal] = lipidManagement.LDLCholesterolGoal
+		facts[common.FactLipidTherapy] = lipidManagement.Therapy
+		if lipidManagement.GoalAttained != nil {
+			facts[common.FactLDLCholesterolGoalAttained] = *lipidManagement.GoalAttained
+			facts[common.FactLDLCholesterolReductionNeeded] = *lipidManagement.ReductionNeeded
+		}
+	}
+
+	if screening := p.MetabolicSyndrome; screening != nil {
+		facts[common.FactMetabolicSyndrome] = screening.Status
+		facts[common.FactMetabolicSyndromeCriteriaMet] = float64(screening.MetCriteriaCount())
+		if criteria := screening.MetCriteriaToString(); criteria != "" {
+			facts[common.FactMetabolicSyndromeCriteria] = criteria
+		}
+		if len(screening.MissingData) > 0 {
+			facts[common.FactMetabolicSyndromeMissingData] = screening.MissingDataToString()
+		}
+	}
+
+	var hasTypeTwoDiabetes bool
+	if p.Diseases != nil {
+		hasTypeTwoDiabetes = p.Diseases.HasTypeTwoDiabetes
+	}
+	glycaemicControl := EvaluateGlycaemicControl(riskCategory, hasTypeTwoDiabetes, p.GlucoseMeasurements, time.Now())
+	facts[common.FactRecentHypoglycaemiaCount] = float64(glycaemicControl.RecentHypoglycaemiaCount)
+	for _, indicator := range glycaemicControl.Indicators {
+		if indicator.Value != nil {
+			facts[indicator.Type] = *indicator.Value
+		}
+		if indicator.Type == common.GlucoseMeasurementGlycatedHemoglobin && indicator.Target > 0 {
+			facts[common.FactGlycatedHemoglobinTarget] = indicator.Target
+		}
+	}
+	if glycaemicControl.TargetAttained != nil {
+		facts[common.FactGlycaemicTargetAttained] = *glycaemicControl.TargetAttained
+	}
+	if indicators := glycaemicControl.IndicatorsAboveTargetToString(); indicators != "" {
+		facts[common.FactGlycaemicIndicatorsAboveTarget] = indicators
+	}
+
+	if len(p.ActivityEntries) > 0 {
+		activity := SummarizeRecentActivity(p.ActivityEntries, time.Now())
+		facts[common.FactWeeklyActivityMinutes] = float64(activity.ModerateEquivalentMinutes)
+		facts[common.FactWeeklyMETMinutes] = activity.METMinutes
+		facts[common.FactActivityTarget] = activity.TargetStatus
+	}
+
+	return facts
+}
+
+// riskReductionsFacts are the facts of the expected risk reductions by the modifiable risk factors
+var riskReductionsFacts = map[string]string{
+	common.RiskFactorSmoking:     common.FactSmokingRiskReduction,
+	common.RiskFactorSBPLevel:    common.FactSBPLevelRiskReduction,
+	common.RiskFactorCholesterol: common.FactCholesterolRiskReduction,
+}
+
+// addRiskReductionsFacts adds the expected risk reductions estimated by the SCORE counterfactuals, i.e. the risk
+// values the user would have if only one of the modifiable risk factors were ideal.
+func addRiskReductionsFacts(facts RecommendationFacts, cardiovascularAge *CardiovascularAge) {
+	for _, factor := range cardiovascularAge.Factors {
+		fact, ok := riskReductionsFacts[factor.Factor]
+		if !ok {
+			continue
+		}
+		if reduction := math.Round((cardiovascularAge.RiskValue-factor.RiskValue)*10) / 10; reduction > 0 {
+			facts[fact] = reduction
+		}
+	}
+}
+
+// overweightBodyMassIndex is the body mass index (kg/m2) the overweight starts from.
+const overweightBodyMassIndex = 25
+
+// addBasicIndicatorsFacts adds the latest known weight, height, waist size and body mass index, the body mass index is
+// calculated by the weight and the height if it is not set or is normal, since the stored one may be outdated.
+func (p RecommendationPatient) addBasicIndicatorsFacts(facts RecommendationFacts) {
+	var weight, height, waistSize, bodyMassIndex float64
+	for _, indicators := range p.BasicIndicators {
+		if indicators.Weight != nil && weight == 0 {
+			weight = *indicators.Weight
+		}
+		if indicators.Height != nil && height == 0 {
+			height = *indicators.Height
+		}
+		if indicators.WaistSize != nil && waistSize == 0 {
+			waistSize = *indicators.WaistSize
+		}
+		if indicators.BodyMassIndex != nil && bodyMassIndex == 0 {
+			bodyMassIndex = *indicators.BodyMassIndex
+		}
+	}
+
+	if bodyMassIndex < overweightBodyMassIndex && weight > 0 && height > 0 {
+		bodyMassIndex = weight / math.Pow(height/100, 2)
+	}
+
+	for fact, value := range map[string]float64{
+		common.FactWeight:        weight,
+		common.FactHeight:        height,
+		common.FactWaistSize:     waistSize,
+		common.FactBodyMassIndex: bodyMassIndex,
+	} {
+		if value > 0 {
+			facts[fact] = value
+		}
+	}
+}
+
+// addRecordFacts adds the fields of the record named as in its JSON representation, the values not satisfying the
+// known function are skipped.
+func addRecordFacts(facts RecommendationFacts, record interface{}, known func(value interface{}) bool) {
+	fields, err := recordFields(record)
+	if err != nil {
+		return
+	}
+
+	for field, value := range fields {
+		if known != nil && !known(value) {
+			continue
+		}
+		facts[field] = value
+	}
+}
+
+// riskFactorsToString lists the modifiable risk factors increasing the cardiovascular age.
+func riskFactorsToString(factors []*RiskFactorContribution) string {
+	descriptions := make([]string, 0, len(factors))
+	for _, factor := range factors {
+		if factor.Years <= 0 {
+			continue
+		}
+		descriptions = append(descriptions, fmt.Sprintf("%s (%s)", riskFactorNames[factor.Factor], yearsToString(factor.Years)))
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// yearsToString returns the number of years with the properly declined word "год".
+func yearsToString(years int) string {
+	switch {
+	case years%100 >= 11 && years%100 <= 14:
+		return fmt.Sprintf("%d лет", years)
+	case years%10 == 1:
+		return fmt.Sprintf("%d год", years)
+	case years%10 >= 2 && years%10 <= 4:
+		return fmt.Sprintf("%d года", years)
+	default:
+		return fmt.Sprintf("%d лет", years)
+	}
+}
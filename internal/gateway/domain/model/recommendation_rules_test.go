@@ -0,0 +1,339 @@
+package model
+
+import (
+	"os"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+)
+
+// recommendationRulesConfigPath is the config the rules are loaded from, so the tests cover the rules actually served.
+const recommendationRulesConfigPath = "../../../../configs/gateway/config.yaml"
+
+// recommendationTestScoreRanges are the ranges of the SCORE risk charts model used by default.
+var recommendationTestScoreRanges = ScoreDataRanges{
+	AgeMin:                   40,
+	AgeMax:                   89,
+	SBPLevelMin:              100.0,
+	SBPLevelMax:              179.0,
+	TotalCholesterolLevelMin: 3.0,
+	TotalCholesterolLevelMax: 6.9,
+}
+
+func loadRecommendationRules(t *testing.T) map[string]*RecommendationRule {
+	t.Helper()
+
+	content, err := os.ReadFile(recommendationRulesConfigPath)
+	if err != nil {
+		t.Fatalf("reading config: %v", err)
+	}
+
+	var cfg struct {
+		Recommendations struct {
+			Rules []*RecommendationRule `yaml:"rules"`
+		} `yaml:"recommendations"`
+	}
+	if err = yaml.Unmarshal(content, &cfg); err != nil {
+		t.Fatalf("parsing config: %v", err)
+	}
+
+	rules := make(map[string]*RecommendationRule, len(cfg.Recommendations.Rules))
+	for _, rule := range cfg.Recommendations.Rules {
+		if err = rule.Validate(); err != nil {
+			t.Fatalf("validating rule: %v", err)
+		}
+		rules[rule.Code] = rule
+	}
+	return rules
+}
+
+// recommendationTestPatient returns the fixture patient none of the ported rules fire for: the 50 years old
+// non-smoking man with the normal blood pressure, cholesterol and weight, the cardiovascular age equal to the real one
+// and the lifestyle not filled.
+func recommendationTestPatient() RecommendationPatient {
+	weight, height := 70.0, 180.0
+	return RecommendationPatient{
+		ScoreData: ScoreData{
+			Age:                   50,
+			Gender:                common.UserGenderMale,
+			SBPLevel:              120,
+			TotalCholesterolLevel: 4.5,
+			HDLCholesterolLevel:   1.3,
+			RiskScope:             common.RiskScopeVeryHigh,
+		},
+		ScoreRanges: recommendationTestScoreRanges,
+		BasicIndicators: []*BasicIndicators{
+			{Weight: &weight, Height: &height},
+		},
+		Diseases:  &Diseases{},
+		Lifestyle: &Lifestyle{},
+		Risk: &RecommendationRisk{
+			Value:             1.5,
+			CardiovascularAge: &CardiovascularAge{Age: 50, RiskValue: 1.5},
+		},
+	}
+}
+
+func float64Pointer(value float64) *float64 {
+	return &value
+}
+
+// TestRecommendationRulesPorted checks the rules ported from the hard-coded recommendations (lifestyle, smoking,
+// sbp_level, bmi, cholesterol_level and risk) fire for the same patients as before. The patients the later extensions
+// of the rules apply to (the activity log, the known LDL cholesterol, the high risk categories) are not covered here.
+func TestRecommendationRulesPorted(t *testing.T) {
+	rules := loadRecommendationRules(t)
+
+	testCases := []struct {
+		name    string
+		rule    string
+		patient func(p *RecommendationPatient)
+		fires   bool
+	}{
+		{
+			name:    "lifestyle: not filled",
+			rule:    "lifestyle",
+			patient: func(p *RecommendationPatient) {},
+		},
+		{
+			name: "lifestyle: rare events participation",
+			rule: "lifestyle",
+			patient: func(p *RecommendationPatient) {
+				p.Lifestyle.EventsParticipation = common.EventsParticipationNotFrequently
+			},
+			fires: true,
+		},
+		{
+			name: "lifestyle: training once a week",
+			rule: "lifestyle",
+			patient: func(p *RecommendationPatient) {
+				p.Lifestyle.PhysicalActivity = common.PhysicalActivityOneInWeek
+			},
+			fires: true,
+		},
+		{
+			name: "smoking: smoker",
+			rule: "smoking",
+			patient: func(p *RecommendationPatient) {
+				p.ScoreData.Smoking = true
+				p.Risk.Value = 3.1
+				p.Risk.ValueNotSmoking = float64Pointer(1.5)
+			},
+			fires: true,
+		},
+		{
+			name:    "smoking: non-smoker",
+			rule:    "smoking",
+			patient: func(p *RecommendationPatient) {},
+		},
+		{
+			name: "smoking: risk can't be calculated",
+			rule: "smoking",
+			patient: func(p *RecommendationPatient) {
+				p.ScoreData.Smoking = true
+				p.Risk = nil
+			},
+		},
+		{
+			name: "sbp_level: hypertension",
+			rule: "sbp_level",
+			patient: func(p *RecommendationPatient) {
+				p.ScoreData.SBPLevel = 150
+			},
+			fires: true,
+		},
+		{
+			name: "sbp_level: high normal",
+			rule: "sbp_level",
+			patient: func(p *RecommendationPatient) {
+				p.ScoreData.SBPLevel = 135
+			},
+		},
+		{
+			name: "sbp_level: out of the ranges",
+			rule: "sbp_level",
+			patient: func(p *RecommendationPatient) {
+				p.ScoreData.SBPLevel = 190
+			},
+		},
+		{
+			name:    "bmi: normal weight",
+			rule:    "bmi",
+			patient: func(p *RecommendationPatient) {},
+		},
+		{
+			name: "bmi: stored overweight",
+			rule: "bmi",
+			patient: func(p *RecommendationPatient) {
+				p.BasicIndicators = []*BasicIndicators{{BodyMassIndex: float64Pointer(27)}}
+			},
+			fires: true,
+		},
+		{
+			name: "bmi: stored normal, overweight by weight and height",
+			rule: "bmi",
+			patient: func(p *RecommendationPatient) {
+				p.BasicIndicators = []*BasicIndicators{
+					{Weight: float64Pointer(90)},
+					{Height: float64Pointer(175), BodyMassIndex: float64Pointer(24)},
+				}
+			},
+			fires: true,
+		},
+		{
+			name: "bmi: stored normal only",
+			rule: "bmi",
+			patient: func(p *RecommendationPatient) {
+				p.BasicIndicators = []*BasicIndicators{{BodyMassIndex: float64Pointer(24)}}
+			},
+		},
+		{
+			name: "bmi: unknown gender",
+			rule: "bmi",
+			patient: func(p *RecommendationPatient) {
+				p.ScoreData.Gender = common.UserGenderUnknown
+				p.BasicIndicators = []*BasicIndicators{{BodyMassIndex: float64Pointer(27)}}
+			},
+		},
+		{
+			name: "cholesterol_level: high cholesterol",
+			rule: "cholesterol_level",
+			patient: func(p *RecommendationPatient) {
+				p.ScoreData.TotalCholesterolLevel = 5.5
+			},
+			fires: true,
+		},
+		{
+			name: "cholesterol_level: borderline cholesterol with diabetes",
+			rule: "cholesterol_level",
+			patient: func(p *RecommendationPatient) {
+				p.ScoreData.TotalCholesterolLevel = 4.8
+				p.Diseases.HasTypeTwoDiabetes = true
+			},
+			fires: true,
+		},
+		{
+			name: "cholesterol_level: borderline cholesterol without diabetes",
+			rule: "cholesterol_level",
+			patient: func(p *RecommendationPatient) {
+				p.ScoreData.TotalCholesterolLevel = 4.8
+			},
+		},
+		{
+			name: "cholesterol_level: body mass index unknown",
+			rule: "cholesterol_level",
+			patient: func(p *RecommendationPatient) {
+				p.ScoreData.TotalCholesterolLevel = 5.5
+				p.BasicIndicators = nil
+			},
+		},
+		{
+			name: "cholesterol_level: unknown gender",
+			rule: "cholesterol_level",
+			patient: func(p *RecommendationPatient) {
+				p.ScoreData.Gender = common.UserGenderUnknown
+				p.ScoreData.TotalCholesterolLevel = 5.5
+			},
+		},
+		{
+			name: "cholesterol_level: out of the ranges",
+			rule: "cholesterol_level",
+			patient: func(p *RecommendationPatient) {
+				p.ScoreData.TotalCholesterolLevel = 7.5
+			},
+		},
+		{
+			name: "risk: cardiovascular age above the real one",
+			rule: "risk",
+			patient: func(p *RecommendationPatient) {
+				p.Risk.CardiovascularAge.Age = 55
+			},
+			fires: true,
+		},
+		{
+			name:    "risk: cardiovascular age equal to the real one",
+			rule:    "risk",
+			patient: func(p *RecommendationPatient) {},
+		},
+		{
+			name: "risk: risk can't be calculated",
+			rule: "risk",
+			patient: func(p *RecommendationPatient) {
+				p.Risk = nil
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			rule, found := rules[testCase.rule]
+			if !found {
+				t.Fatalf("rule %v not found", testCase.rule)
+			}
+
+			patient := recommendationTestPatient()
+			testCase.patient(&patient)
+
+			recommendations, err := EvaluateRecommendationRules([]*RecommendationRule{rule}, patient.Facts())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if fired := len(recommendations) > 0; fired != testCase.fires {
+				t.Errorf("rule %v fired: %v, want %v", testCase.rule, fired, testCase.fires)
+			}
+		})
+	}
+}
+
+func TestRecommendationPatientFactsBodyMassIndex(t *testing.T) {
+	testCases := []struct {
+		name       string
+		indicators []*BasicIndicators
+		want       float64
+		known      bool
+	}{
+		{
+			name: "stored",
+			indicators: []*BasicIndicators{
+				{BodyMassIndex: float64Pointer(27), Weight: float64Pointer(70), Height: float64Pointer(180)},
+			},
+			want:  27,
+			known: true,
+		},
+		{
+			name:       "calculated if not stored",
+			indicators: []*BasicIndicators{{Weight: float64Pointer(81), Height: float64Pointer(180)}},
+			want:       25,
+			known:      true,
+		},
+		{
+			name: "calculated if stored normal",
+			indicators: []*BasicIndicators{
+				{BodyMassIndex: float64Pointer(22), Weight: float64Pointer(81), Height: float64Pointer(180)},
+			},
+			want:  25,
+			known: true,
+		},
+		{
+			name:       "unknown",
+			indicators: []*BasicIndicators{{Weight: float64Pointer(81)}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			patient := RecommendationPatient{BasicIndicators: testCase.indicators}
+
+			value, known := patient.Facts()[common.FactBodyMassIndex]
+			if known != testCase.known {
+				t.Fatalf("body mass index known: %v, want %v", known, testCase.known)
+			}
+			if known && value != testCase.want {
+				t.Errorf("got body mass index %v, want %v", value, testCase.want)
+			}
+		})
+	}
+}
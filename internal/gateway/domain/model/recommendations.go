@@ -1,9 +1,21 @@
 package model
 
+import "errors"
+
+var ErrInvalidRecommendationsLimit = errors.New("invalid recommendations limit")
+
 type Recommendation struct {
-	What string `json:"what"`
-	Why  string `json:"why"`
-	How  string `json:"how"`
+	// Code is the code of the recommendation rule
+	Code     string `json:"code"`
+	Category string `json:"category"`
+	// Priority is the rank of the recommendation starting from 1, see EvaluateRecommendationRules
+	Priority int `json:"priority"`
+	// RiskReduction is the expected cardiovascular events risk reduction in percentage points if the recommendation is
+	// followed, nil if it is not estimated
+	RiskReduction *float64 `json:"riskReduction,omitempty"`
+	What          string   `json:"what"`
+	Why           string   `json:"why"`
+	How           string   `json:"how"`
 }
 
 type RecommendationType int
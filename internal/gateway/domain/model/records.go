@@ -0,0 +1,49 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+var ErrInvalidRecordsCriteria = errors.New("invalid records criteria")
+
+// MaxRecordsLimit is the maximum number of the user records (analyses, basic indicators) returned at once.
+const MaxRecordsLimit = 100
+
+// RecordsCriteria represents the criteria of the user records (analyses, basic indicators) listing. The records are
+// sorted from the newest to the oldest.
+type RecordsCriteria struct {
+	UserID uint64
+	// creation dates range, the bounds are optional and inclusive
+	From time.Time
+	To   time.Time
+	// Cursor is the id of the last record of the previous page, 0 means the first page
+	Cursor uint64
+	// Limit is the page size, 0 means no limit
+	Limit int64
+}
+
+func (c RecordsCriteria) Validate() error {
+	if c.Limit < 0 || c.Limit > MaxRecordsLimit {
+		return fmt.Errorf("%w: limit must be from 0 to %v", ErrInvalidRecordsCriteria, MaxRecordsLimit)
+	}
+	if !c.From.IsZero() && !c.To.IsZero() && c.From.After(c.To) {
+		return fmt.Errorf("%w: from must be no later than to", ErrInvalidRecordsCriteria)
+	}
+	return nil
+}
+
+// AnalysisRevision represents the analysis values before the change.
+type AnalysisRevision struct {
+	Analysis  *Analysis      `json:"analysis"`
+	ChangedAt model.Datetime `json:"changedAt"`
+}
+
+// BasicIndicatorsRevision represents the basic indicators values before the change.
+type BasicIndicatorsRevision struct {
+	BasicIndicators *BasicIndicators `json:"basicIndicators"`
+	ChangedAt       model.Datetime   `json:"changedAt"`
+}
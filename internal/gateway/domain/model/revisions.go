@@ -0,0 +1,144 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+var ErrRevisionNotFound = errors.New("revision with this id not found")
+
+// Revision represents the state of the user record (diseases, lifestyle, questionnaire) saved on its update. The
+// Record is the pointer to the record structure.
+type Revision struct {
+	ID        uint64         `json:"id"`
+	Record    interface{}    `json:"record"`
+	CreatedAt model.Datetime `json:"createdAt"`
+}
+
+// RevisionChange represents the change of the record field, the field is named as in the JSON representation.
+type RevisionChange struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+// RevisionsDiff represents the changes of the record from one revision to another.
+type RevisionsDiff struct {
+	From    *Revision         `json:"from"`
+	To      *Revision         `json:"to"`
+	Changes []*RevisionChange `json:"changes"`
+}
+
+// RevisionEvolution represents the changes made by the revision to the previous one, all the fields are changed by
+// the first revision.
+type RevisionEvolution struct {
+	ID        uint64            `json:"id"`
+	CreatedAt model.Datetime    `json:"createdAt"`
+	Changes   []*RevisionChange `json:"changes"`
+}
+
+// DiffRevisions returns the changes of the record from one revision to another. The nil from revision means the
+// record before the first revision.
+func DiffRevisions(from, to *Revision) (*RevisionsDiff, error) {
+	var fromRecord interface{}
+	if from != nil {
+		fromRecord = from.Record
+	}
+
+	changes, err := diffRecords(fromRecord, to.Record)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RevisionsDiff{
+		From:    from,
+		To:      to,
+		Changes: changes,
+	}, nil
+}
+
+// RevisionsEvolution returns the changes made by each of the revisions sorted from the newest to the oldest. The
+// revisions with no changes are skipped.
+func RevisionsEvolution(revisions []*Revision) ([]*RevisionEvolution, error) {
+	evolution := make([]*RevisionEvolution, 0, len(revisions))
+	for i, revision := range revisions {
+		var previous *Revision
+		if i+1 < len(revisions) {
+			previous = revisions[i+1]
+		}
+
+		diff, err := DiffRevisions(previous, revision)
+		if err != nil {
+			return nil, err
+		}
+		if len(diff.Changes) == 0 {
+			continue
+		}
+
+		evolution = append(evolution, &RevisionEvolution{
+			ID:        revision.ID,
+			CreatedAt: revision.CreatedAt,
+			Changes:   diff.Changes,
+		})
+	}
+	return evolution, nil
+}
+
+// diffRecords compares the JSON representations of the records field by field, the changes are sorted by the field.
+func diffRecords(from, to interface{}) ([]*RevisionChange, error) {
+	fromFields, err := recordFields(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toFields, err := recordFields(to)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, 0, len(toFields))
+	for field := range toFields {
+		fields = append(fields, field)
+	}
+	for field := range fromFields {
+		if _, found := toFields[field]; !found {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	changes := make([]*RevisionChange, 0, len(fields))
+	for _, field := range fields {
+		if reflect.DeepEqual(fromFields[field], toFields[field]) {
+			continue
+		}
+
+		changes = append(changes, &RevisionChange{
+			Field: field,
+			From:  fromFields[field],
+			To:    toFields[field],
+		})
+	}
+	return changes, nil
+}
+
+func recordFields(record interface{}) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	if value := reflect.ValueOf(record); !value.IsValid() || (value.Kind() == reflect.Ptr && value.IsNil()) {
+		return fields, nil
+	}
+
+	recordBytes, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(recordBytes, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
@@ -0,0 +1,238 @@
+package model
+
+import (
+	"errors"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+)
+
+var ErrNotEnoughDataToClassifyRisk = errors.New("not enough data to classify cardiovascular risk")
+
+// RiskCategoryNames are the ESC cardiovascular risk categories names.
+var RiskCategoryNames = map[string]string{
+	common.RiskCategoryLow:      "низкий",
+	common.RiskCategoryModerate: "умеренный",
+	common.RiskCategoryHigh:     "высокий",
+	common.RiskCategoryVeryHigh: "очень высокий",
+}
+
+// riskCategoryReasons describe the conditions of the risk category rules
+var riskCategoryReasons = map[string]string{
+	common.RiskCategoryRuleASCVD:                         "подтверждённое атеросклеротическое сердечно-сосудистое заболевание: инфаркт, инсульт, ишемическая болезнь сердца или атеросклероз",
+	common.RiskCategoryRuleAtheroscleroticPlaques:        "атеросклеротические бляшки по данным исследования",
+	common.RiskCategoryRuleSevereCKD:                     "тяжёлая хроническая болезнь почек (СКФ менее 30 мл/мин/1,73 м²)",
+	common.RiskCategoryRuleDiabetesWithTargetOrganDamage: "сахарный диабет с поражением почек",
+	common.RiskCategoryRuleScoreVeryHigh:                 "очень высокий риск по шкале SCORE",
+	common.RiskCategoryRuleMarkedlyElevatedCholesterol:   "значительно повышенный общий холестерин (более 8 ммоль/л)",
+	common.RiskCategoryRuleMarkedlyElevatedLDL:           "значительно повышенный холестерин ЛПНП (более 4,9 ммоль/л)",
+	common.RiskCategoryRuleMarkedlyElevatedSBP:           "значительно повышенное систолическое артериальное давление (180 мм рт. ст. и более)",
+	common.RiskCategoryRuleDiabetes:                      "сахарный диабет",
+	common.RiskCategoryRuleModerateCKD:                   "хроническая болезнь почек (СКФ 30-59 мл/мин/1,73 м²)",
+	common.RiskCategoryRuleScoreHigh:                     "высокий риск по шкале SCORE",
+	common.RiskCategoryRuleScoreModerate:                 "умеренный риск по шкале SCORE",
+	common.RiskCategoryRuleScoreLow:                      "низкий риск по шкале SCORE",
+}
+
+// the thresholds of the markedly elevated risk factors and the chronic kidney disease stages by eGFR
+const (
+	markedlyElevatedCholesterolLevel    = 8.0
+	markedlyElevatedLDLLevel            = 4.9
+	markedlyElevatedSBPLevel            = 180.0
+	severeCKDGlomerularFiltrationRate   = 30.0
+	moderateCKDGlomerularFiltrationRate = 60.0
+)
+
+// scoreRiskThresholds are the lower bounds of the moderate, high and very high risk categories by the SCORE2 risk
+// values according to the age (ESC 2021 guidelines). The guidelines combine the low and the moderate categories, so
+// the moderate one starts at the half of the high one.
+type scoreRiskThresholds struct {
+	maxAge   int // inclusive, 0 means no limit
+	moderate float64
+	high     float64
+	veryHigh float64
+}
+
+var scoreRisksThresholds = []scoreRiskThresholds{
+	{maxAge: 49, moderate: 1.25, high: 2.5, veryHigh: 7.5},
+	{maxAge: 69, moderate: 2.5, high: 5, veryHigh: 10},
+	{moderate: 3.75, high: 7.5, veryHigh: 15},
+}
+
+// RiskCategoryData represents the patient data the cardiovascular risk category is classified by.
+type RiskCategoryData struct {
+	Age      int
+	Diseases *Diseases
+	// the latest known analyses values, nil if unknown
+	EstimatedGlomerularFiltrationRate *float64
+	LowDensityCholesterol             *float64
+	AtheroscleroticPlaquesPresence    bool
+	// the latest basic indicators values, 0 if unknown
+	TotalCholesterolLevel float64
+	SBPLevel              float64
+	// RiskValue is the SCORE risk value, nil if the SCORE data is insufficient
+	RiskValue *float64
+}
+
+// NewRiskCategoryData collects the risk category data from the SCORE data, the diseases and the analyses sorted from
+// the newest to the oldest with the derived values calculated (see Analysis.Derive).
+func NewRiskCategoryData(scoreData ScoreData, riskValue *float64, diseases *Diseases, analyses []*Analysis) RiskCategoryData {
+	data := RiskCategoryData{
+		Age:                   scoreData.Age,
+		Diseases:              diseases,
+		TotalCholesterolLevel: scoreData.TotalCholesterolLevel,
+		SBPLevel:              scoreData.SBPLevel,
+		RiskValue:             riskValue,
+	}
+
+	for _, analysis := range analyses {
+		if data.EstimatedGlomerularFiltrationRate == nil {
+			data.EstimatedGlomerularFiltrationRate = analysis.EstimatedGlomerularFiltrationRate
+		}
+		if data.LowDensityCholesterol == nil {
+			data.LowDensityCholesterol = analysis.LowDensityCholesterol
+		}
+		if analysis.AtheroscleroticPlaquesPresence != nil && *analysis.AtheroscleroticPlaquesPresence {
+			data.AtheroscleroticPlaquesPresence = true
+		}
+	}
+
+	return data
+}
+
+// RiskCategory represents the ESC cardiovascular risk category and the rule it is classified by.
+type RiskCategory struct {
+	Category string `json:"category"`
+	Name     string `json:"name"`
+	Rule     string `json:"rule"`
+	Reason   string `json:"reason"`
+	// RiskValue is the SCORE risk value, nil if the SCORE data is insufficient
+	RiskValue *float64 `json:"riskValue,omitempty"`
+}
+
+// ClassifyRiskCategory classifies the cardiovascular risk according to the ESC guidelines: the documented diseases and
+// the markedly elevated risk factors determine the category regardless of SCORE, otherwise the category is determined
+// by the SCORE risk value. The first satisfied rule is applied, nil is returned if the data is insufficient.
+func ClassifyRiskCategory(data RiskCategoryData) *RiskCategory {
+	diseases := data.Diseases
+	if diseases == nil {
+		diseases = &Diseases{}
+	}
+
+	eGFRBelow := func(bound float64) bool {
+		return data.EstimatedGlomerularFiltrationRate != nil && *data.EstimatedGlomerularFiltrationRate < bound
+	}
+	moderateCKD := diseases.HasChronicKidneyDisease || eGFRBelow(moderateCKDGlomerularFiltrationRate)
+
+	rules := []struct {
+		category  string
+		rule      string
+		satisfied bool
+	}{
+		{
+			category:  common.RiskCategoryVeryHigh,
+			rule:      common.RiskCategoryRuleASCVD,
+			satisfied: diseases.HadInfarctionOrStroke || diseases.HasIschemicHeartDisease || diseases.HasAtherosclerosis,
+		},
+		{
+			category:  common.RiskCategoryVeryHigh,
+			rule:      common.RiskCategoryRuleAtheroscleroticPlaques,
+			satisfied: data.AtheroscleroticPlaquesPresence,
+		},
+		{
+			category:  common.RiskCategoryVeryHigh,
+			rule:      common.RiskCategoryRuleSevereCKD,
+			satisfied: eGFRBelow(severeCKDGlomerularFiltrationRate),
+		},
+		{
+			category:  common.RiskCategoryVeryHigh,
+			rule:      common.RiskCategoryRuleDiabetesWithTargetOrganDamage,
+			satisfied: diseases.HasTypeTwoDiabetes && moderateCKD,
+		},
+		{
+			category:  common.RiskCategoryVeryHigh,
+			rule:      common.RiskCategoryRuleScoreVeryHigh,
+			satisfied: data.scoreCategory() == common.RiskCategoryVeryHigh,
+		},
+		{
+			category:  common.RiskCategoryHigh,
+			rule:      common.RiskCategoryRuleMarkedlyElevatedCholesterol,
+			satisfied: data.TotalCholesterolLevel > markedlyElevatedCholesterolLevel,
+		},
+		{
+			category:  common.RiskCategoryHigh,
+			rule:      common.RiskCategoryRuleMarkedlyElevatedLDL,
+			satisfied: data.LowDensityCholesterol != nil && *data.LowDensityCholesterol > markedlyElevatedLDLLevel,
+		},
+		{
+			category:  common.RiskCategoryHigh,
+			rule:      common.RiskCategoryRuleMarkedlyElevatedSBP,
+			satisfied: data.SBPLevel >= markedlyElevatedSBPLevel,
+		},
+		{
+			category:  common.RiskCategoryHigh,
+			rule:      common.RiskCategoryRuleDiabetes,
+			satisfied: diseases.HasTypeTwoDiabetes,
+		},
+		{
+			category:  common.RiskCategoryHigh,
+			rule:      common.RiskCategoryRuleModerateCKD,
+			satisfied: moderateCKD,
+		},
+		{
+			category:  common.RiskCategoryHigh,
+			rule:      common.RiskCategoryRuleScoreHigh,
+			satisfied: data.scoreCategory() == common.RiskCategoryHigh,
+		},
+		{
+			category:  common.RiskCategoryModerate,
+			rule:      common.RiskCategoryRuleScoreModerate,
+			satisfied: data.scoreCategory() == common.RiskCategoryModerate,
+		},
+		{
+			category:  common.RiskCategoryLow,
+			rule:      common.RiskCategoryRuleScoreLow,
+			satisfied: data.scoreCategory() == common.RiskCategoryLow,
+		},
+	}
+
+	for _, rule := range rules {
+		if rule.satisfied {
+			return &RiskCategory{
+				Category:  rule.category,
+				Name:      RiskCategoryNames[rule.category],
+				Rule:      rule.rule,
+				Reason:    riskCategoryReasons[rule.rule],
+				RiskValue: data.RiskValue,
+			}
+		}
+	}
+
+	return nil
+}
+
+// scoreCategory returns the risk category by the SCORE risk value, empty string if the risk value or the age is
+// unknown.
+func (d RiskCategoryData) scoreCategory() string {
+	if d.RiskValue == nil || d.Age <= 0 {
+		return ""
+	}
+
+	for _, thresholds := range scoreRisksThresholds {
+		if thresholds.maxAge != 0 && d.Age > thresholds.maxAge {
+			continue
+		}
+
+		switch riskValue := *d.RiskValue; {
+		case riskValue >= thresholds.veryHigh:
+			return common.RiskCategoryVeryHigh
+		case riskValue >= thresholds.high:
+			return common.RiskCategoryHigh
+		case riskValue >= thresholds.moderate:
+			return common.RiskCategoryModerate
+		default:
+			return common.RiskCategoryLow
+		}
+	}
+
+	return ""
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 
@@ -11,20 +13,35 @@ import (
 )
 
 var (
-	ErrInvalidAge       = errors.New("invalid age value")
-	ErrInvalidScoreData = errors.New("invalid SCORE data")
+	ErrInvalidAge                 = errors.New("invalid age value")
+	ErrInvalidRiskScope           = errors.New("invalid riskScope value")
+	ErrInvalidHDLCholesterolLevel = errors.New("invalid hdlCholesterolLevel value")
+	ErrInvalidScoreData           = errors.New("invalid SCORE data")
+	ErrInvalidScoreScenarios      = errors.New("invalid SCORE scenarios")
 )
 
+// MaxScoreScenarios is the maximum number of scenarios simulated at once.
+const MaxScoreScenarios = 50
+
 type ScoreData struct {
 	Age                   int     `json:"age"` // receive from user data
 	Gender                string  `query:"gender"`
 	Smoking               bool    `query:"smoking"`
 	SBPLevel              float64 `query:"sbpLevel"`
 	TotalCholesterolLevel float64 `query:"totalCholesterolLevel"`
+	HDLCholesterolLevel   float64 `json:"hdlCholesterolLevel" query:"hdlCholesterolLevel"` // optional, receive from the latest analysis if not set
+	RiskScope             string  `json:"riskScope" query:"riskScope"`                     // resolved from user region if not set
 }
 
-func ExtractScoreDataFrom(indicators []*BasicIndicators) ScoreData {
-	var data ScoreData
+// ExtractScoreDataFrom returns the SCORE data from the most recent basic indicators containing the values, the basic
+// indicators are expected to be sorted from the newest to the oldest. The systolic blood pressure is taken from the
+// latest sufficient weekly home blood pressure average (see BloodPressureWeeklyAverages) if it is more recent than
+// the basic indicators one.
+func ExtractScoreDataFrom(indicators []*BasicIndicators, bloodPressureAverages []*BloodPressureWeeklyAverage) ScoreData {
+	var (
+		data          ScoreData
+		sbpMeasuredAt time.Time
+	)
 	for _, basicIndicator := range indicators {
 		if basicIndicator.Smoking != nil && *basicIndicator.Smoking {
 			data.Smoking = true
@@ -34,6 +51,7 @@ func ExtractScoreDataFrom(indicators []*BasicIndicators) ScoreData {
 		}
 		if basicIndicator.SBPLevel != nil && data.SBPLevel == 0 {
 			data.SBPLevel = *basicIndicator.SBPLevel
+			sbpMeasuredAt = basicIndicator.CreatedAt.Time
 		}
 		if basicIndicator.TotalCholesterolLevel != nil && data.TotalCholesterolLevel == 0 {
 			data.TotalCholesterolLevel = *basicIndicator.TotalCholesterolLevel
@@ -44,21 +62,125 @@ func ExtractScoreDataFrom(indicators []*BasicIndicators) ScoreData {
 			break
 		}
 	}
+
+	average := LatestSufficientBloodPressureAverage(bloodPressureAverages)
+	if average != nil && (data.SBPLevel == 0 || average.LastMeasuredAt.After(sbpMeasuredAt)) {
+		data.SBPLevel = average.Systolic
+	}
+
 	return data
 }
 
+// ExtractHDLCholesterolLevelFrom returns the HDL cholesterol level from the most recent analysis containing it. The
+// analyses are expected to be sorted from the newest to the oldest. If HDL cholesterol is unknown, 0 is returned.
+func ExtractHDLCholesterolLevelFrom(analyses []*Analysis) float64 {
+	for _, analysis := range analyses {
+		if analysis.HighDensityCholesterol != nil && *analysis.HighDensityCholesterol > 0 {
+			return *analysis.HighDensityCholesterol
+		}
+	}
+	return 0
+}
+
+// NonHDLCholesterolLevel returns the non-HDL cholesterol level calculated as total cholesterol minus HDL cholesterol.
+//
+// If HDL cholesterol is unknown (or is inconsistent with total cholesterol), total cholesterol is returned instead and
+// the value is marked as approximate.
+func (d ScoreData) NonHDLCholesterolLevel() (level float64, approximate bool) {
+	// the risk charts contain non-HDL cholesterol values rounded to one decimal place
+	if d.HDLCholesterolLevel <= 0 || d.HDLCholesterolLevel >= d.TotalCholesterolLevel {
+		return math.Round(d.TotalCholesterolLevel*10) / 10, true
+	}
+
+	return math.Round((d.TotalCholesterolLevel-d.HDLCholesterolLevel)*10) / 10, false
+}
+
+// CardiovascularAge represents the cardiovascular (vascular) age, i.e. the age at which a person with the ideal
+// profile of the modifiable risk factors has the same risk as the user.
+type CardiovascularAge struct {
+	Age       int                       `json:"age"`
+	RiskValue float64                   `json:"riskValue"`
+	Scale     string                    `json:"scale"`
+	Factors   []*RiskFactorContribution `json:"factors"`
+}
+
+// RiskFactorContribution represents the contribution of a single modifiable risk factor to the cardiovascular age.
+// The contribution is estimated by bringing only this factor to its ideal value.
+type RiskFactorContribution struct {
+	Factor string `json:"factor"`
+	// Years is the number of years the cardiovascular age would decrease by
+	Years int `json:"years"`
+	// RiskValue is the risk value the user would have
+	RiskValue float64 `json:"riskValue"`
+}
+
+// ScoreScenario represents the "what-if" overrides of the user SCORE data. Nil fields keep the user values.
+type ScoreScenario struct {
+	Name                  string   `json:"name"` // optional, returned as is
+	Smoking               *bool    `json:"smoking"`
+	SBPLevel              *float64 `json:"sbpLevel"`
+	TotalCholesterolLevel *float64 `json:"totalCholesterolLevel"`
+	HDLCholesterolLevel   *float64 `json:"hdlCholesterolLevel"`
+}
+
+// ApplyTo returns the SCORE data with the scenario overrides applied.
+func (s ScoreScenario) ApplyTo(data ScoreData) ScoreData {
+	if s.Smoking != nil {
+		data.Smoking = *s.Smoking
+	}
+	if s.SBPLevel != nil {
+		data.SBPLevel = *s.SBPLevel
+	}
+	if s.TotalCholesterolLevel != nil {
+		data.TotalCholesterolLevel = *s.TotalCholesterolLevel
+	}
+	if s.HDLCholesterolLevel != nil {
+		data.HDLCholesterolLevel = *s.HDLCholesterolLevel
+	}
+	return data
+}
+
+// ScoreSimulation represents the results of the "what-if" scenarios compared with the user actual data.
+type ScoreSimulation struct {
+	Baseline  *ScoreSimulationResult   `json:"baseline"`
+	Scenarios []*ScoreSimulationResult `json:"scenarios"`
+}
+
+type ScoreSimulationResult struct {
+	Name              string  `json:"name,omitempty"`
+	RiskValue         float64 `json:"riskValue"`
+	Scale             string  `json:"scale"`
+	CardiovascularAge int     `json:"cardiovascularAge"`
+	// RiskDifference is the difference between the scenario and baseline risk values
+	RiskDifference float64 `json:"riskDifference"`
+	// Approximate is true if HDL cholesterol is unknown and total cholesterol is used instead of non-HDL one
+	Approximate bool `json:"approximate"`
+}
+
+// ScoreDataRanges describes the SCORE data values ranges supported by the risk model.
+type ScoreDataRanges struct {
+	AgeMin                   int
+	AgeMax                   int
+	SBPLevelMin              float64
+	SBPLevelMax              float64
+	TotalCholesterolLevelMin float64
+	TotalCholesterolLevelMax float64
+}
+
 type ValidationOptionsScore struct {
 	Age                   bool
 	Gender                bool
 	SBPLevel              bool
 	TotalCholesterolLevel bool
+	RiskScope             bool
+	Ranges                ScoreDataRanges
 }
 
 func (d ScoreData) Validate(params ValidationOptionsScore) error {
 	err := validation.ValidateStruct(&d,
 		validation.Field(&d.Age, validation.When(
 			params.Age,
-			validation.Min(40), validation.Max(89),
+			validation.Min(params.Ranges.AgeMin), validation.Max(params.Ranges.AgeMax),
 		)),
 		validation.Field(&d.Gender, validation.When(
 			params.Gender,
@@ -66,11 +188,16 @@ func (d ScoreData) Validate(params ValidationOptionsScore) error {
 		)),
 		validation.Field(&d.SBPLevel, validation.When(
 			params.SBPLevel,
-			validation.Min(100.0), validation.Max(179.0),
+			validation.Min(params.Ranges.SBPLevelMin), validation.Max(params.Ranges.SBPLevelMax),
 		)),
 		validation.Field(&d.TotalCholesterolLevel, validation.When(
 			params.TotalCholesterolLevel,
-			validation.Min(3.0), validation.Max(6.9),
+			validation.Min(params.Ranges.TotalCholesterolLevelMin), validation.Max(params.Ranges.TotalCholesterolLevelMax),
+		)),
+		validation.Field(&d.HDLCholesterolLevel, validation.Min(0.5), validation.Max(5.5)),
+		validation.Field(&d.RiskScope, validation.When(
+			params.RiskScope,
+			validation.Required, validation.In(common.RiskScopeLow, common.RiskScopeModerate, common.RiskScopeHigh, common.RiskScopeVeryHigh),
 		)),
 	)
 	if err != nil {
@@ -97,13 +224,19 @@ func (d ScoreData) Validate(params ValidationOptionsScore) error {
 		if validationError, found := validationErrors["age"]; found {
 			return fmt.Errorf("%w: %v", ErrInvalidAge, validationError)
 		}
+		if validationError, found := validationErrors["riskScope"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidRiskScope, validationError)
+		}
+		if validationError, found := validationErrors["hdlCholesterolLevel"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidHDLCholesterolLevel, validationError)
+		}
 
 		return ErrInvalidScoreData
 	}
 	return nil
 }
 
-func (d ScoreData) ValidateByRecommendation(recommendationType RecommendationType) error {
+func (d ScoreData) ValidateByRecommendation(recommendationType RecommendationType, ranges ScoreDataRanges) error {
 	switch recommendationType {
 	case Smoking, Risk:
 		return d.Validate(ValidationOptionsScore{
@@ -111,20 +244,24 @@ func (d ScoreData) ValidateByRecommendation(recommendationType RecommendationTyp
 			Gender:                true,
 			SBPLevel:              true,
 			TotalCholesterolLevel: true,
+			Ranges:                ranges,
 		})
 	case SBPLevel:
 		return d.Validate(ValidationOptionsScore{
 			SBPLevel: true,
+			Ranges:   ranges,
 		})
 	case BMI:
 		return d.Validate(ValidationOptionsScore{
 			Gender: true,
+			Ranges: ranges,
 		})
 	case CholesterolLevel:
 		return d.Validate(ValidationOptionsScore{
 			Gender:                true,
 			SBPLevel:              true,
 			TotalCholesterolLevel: true,
+			Ranges:                ranges,
 		})
 	default:
 		return d.Validate(ValidationOptionsScore{
@@ -132,6 +269,7 @@ func (d ScoreData) ValidateByRecommendation(recommendationType RecommendationTyp
 			Gender:                true,
 			SBPLevel:              true,
 			TotalCholesterolLevel: true,
+			Ranges:                ranges,
 		})
 	}
 }
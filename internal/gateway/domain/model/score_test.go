@@ -0,0 +1,54 @@
+package model
+
+import "testing"
+
+func TestScoreDataNonHDLCholesterolLevel(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		totalCholesterolLevel float64
+		hdlCholesterolLevel   float64
+		want                  float64
+		approximate           bool
+	}{
+		{
+			name:                  "exact",
+			totalCholesterolLevel: 5.27,
+			hdlCholesterolLevel:   1.3,
+			want:                  4.0,
+		},
+		{
+			name:                  "approximate by total cholesterol rounded up",
+			totalCholesterolLevel: 3.95,
+			want:                  4.0,
+			approximate:           true,
+		},
+		{
+			name:                  "approximate by total cholesterol rounded down",
+			totalCholesterolLevel: 3.94,
+			want:                  3.9,
+			approximate:           true,
+		},
+		{
+			name:                  "approximate by HDL cholesterol inconsistent with total one",
+			totalCholesterolLevel: 4.26,
+			hdlCholesterolLevel:   4.5,
+			want:                  4.3,
+			approximate:           true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			data := ScoreData{
+				TotalCholesterolLevel: testCase.totalCholesterolLevel,
+				HDLCholesterolLevel:   testCase.hdlCholesterolLevel,
+			}
+
+			level, approximate := data.NonHDLCholesterolLevel()
+			if level != testCase.want || approximate != testCase.approximate {
+				t.Errorf("got %v (approximate: %v), want %v (approximate: %v)",
+					level, approximate, testCase.want, testCase.approximate)
+			}
+		})
+	}
+}
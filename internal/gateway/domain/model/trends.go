@@ -0,0 +1,166 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+var (
+	ErrInvalidTrendPeriod    = errors.New("invalid period value")
+	ErrInvalidTrendMetrics   = errors.New("invalid metrics value")
+	ErrInvalidTrendsCriteria = errors.New("invalid trends criteria")
+)
+
+// MaxTrendMetrics is the maximum number of the metrics requested at once.
+const MaxTrendMetrics = 50
+
+// trendPrecision is the number of decimal places of the trends values.
+const trendPrecision = 2
+
+// TrendsCriteria represents the criteria of the user metrics series.
+type TrendsCriteria struct {
+	UserID uint64
+	// Metrics are the basic indicators values names and the lab tests codes, empty means all the metrics
+	Metrics []string `json:"metrics"`
+	Period  string   `json:"period"`
+	// measurements dates range, the bounds are optional and inclusive
+	From time.Time
+	To   time.Time
+}
+
+func (c TrendsCriteria) Validate() error {
+	err := validation.ValidateStruct(&c,
+		validation.Field(&c.Metrics,
+			validation.Length(0, MaxTrendMetrics),
+			validation.Each(validation.Required, validation.Match(labTestCodeRegexp)),
+		),
+		validation.Field(&c.Period,
+			validation.Required, validation.In(common.TrendPeriodDay, common.TrendPeriodWeek, common.TrendPeriodMonth),
+		),
+	)
+	if err != nil {
+		var errBytes []byte
+		errBytes, err = json.Marshal(err)
+		if err != nil {
+			return err
+		}
+
+		var validationErrors map[string]string
+		if err = json.Unmarshal(errBytes, &validationErrors); err != nil {
+			return err
+		}
+
+		if validationError, found := validationErrors["period"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidTrendPeriod, validationError)
+		}
+		if validationError, found := validationErrors["metrics"]; found {
+			return fmt.Errorf("%w: %v", ErrInvalidTrendMetrics, validationError)
+		}
+
+		return ErrInvalidTrendsCriteria
+	}
+
+	if !c.From.IsZero() && !c.To.IsZero() && c.From.After(c.To) {
+		return fmt.Errorf("%w: from must be no later than to", ErrInvalidTrendsCriteria)
+	}
+
+	return nil
+}
+
+// TrendSeries represents the metric measurements resampled by the period.
+type TrendSeries struct {
+	Metric  string         `json:"metric"`
+	Unit    string         `json:"unit,omitempty"`
+	Buckets []*TrendBucket `json:"buckets"`
+	// SlopePerDay is the slope of the linear trend of the measurements (change per day), it is nil if the trend
+	// can't be calculated, e.g. there is the only measurement
+	SlopePerDay *float64 `json:"slopePerDay"`
+	// the first and the last measurements of the series
+	First float64 `json:"first"`
+	Last  float64 `json:"last"`
+	// ChangeSinceFirst is the difference between the last and the first measurements
+	ChangeSinceFirst float64 `json:"changeSinceFirst"`
+}
+
+// TrendBucket represents the metric measurements aggregated by the period (day, week or month).
+type TrendBucket struct {
+	Start model.Date `json:"start"`
+	Min   float64    `json:"min"`
+	Max   float64    `json:"max"`
+	Mean  float64    `json:"mean"`
+	Count int64      `json:"count"`
+}
+
+// trendMeasurements binds the metrics to the units they can be measured in.
+var trendMeasurements = map[string]measurement{
+	common.TrendMetricWeight:                            measurementWeight,
+	common.TrendMetricWaistSize:                         measurementLength,
+	common.TrendMetricTotalCholesterolLevel:             measurementCholesterol,
+	common.AnalysisValueHighDensityCholesterol:          measurementCholesterol,
+	common.AnalysisValueLowDensityCholesterol:           measurementCholesterol,
+	common.AnalysisValueTriglycerides:                   measurementTriglycerides,
+	common.AnalysisValueLipoprotein:                     measurementLipoprotein,
+	common.AnalysisValueHighlySensitiveCReactiveProtein: measurementCReactiveProtein,
+	common.AnalysisValueCreatinine:                      measurementCreatinine,
+	common.LabTestGlucose:                               measurementGlucose,
+	common.GlucoseMeasurementFasting:                    measurementGlucose,
+	common.GlucoseMeasurementPostprandial:               measurementGlucose,
+	common.GlucoseMeasurementGlycatedHemoglobin:         measurementGlycatedHemoglobin,
+}
+
+// ConvertUnits converts the SI values of the series to the unit system and declares the unit of the series. The
+// unit is kept as is if the metric has no known units.
+func (s *TrendSeries) ConvertUnits(unitSystem string) {
+	measurement, found := trendMeasurements[s.Metric]
+	if !found {
+		return
+	}
+
+	if unitSystem != common.UnitSystemUS {
+		s.Unit = measurement.si
+		return
+	}
+
+	// all the units are proportional, so the slope and the change are converted by the same factor
+	for _, bucket := range s.Buckets {
+		bucket.Min /= measurement.factor
+		bucket.Max /= measurement.factor
+		bucket.Mean /= measurement.factor
+	}
+	if s.SlopePerDay != nil {
+		slopePerDay := *s.SlopePerDay / measurement.factor
+		s.SlopePerDay = &slopePerDay
+	}
+	s.First /= measurement.factor
+	s.Last /= measurement.factor
+	s.ChangeSinceFirst /= measurement.factor
+	s.Unit = measurement.us
+
+	s.Round()
+}
+
+// Round rounds the series values, the slope is rounded to the significant digits since it may be small.
+func (s *TrendSeries) Round() {
+	for _, bucket := range s.Buckets {
+		bucket.Min = roundTo(bucket.Min, trendPrecision)
+		bucket.Max = roundTo(bucket.Max, trendPrecision)
+		bucket.Mean = roundTo(bucket.Mean, trendPrecision)
+	}
+	if s.SlopePerDay != nil && *s.SlopePerDay != 0 {
+		magnitude := math.Floor(math.Log10(math.Abs(*s.SlopePerDay)))
+		precision := int(math.Max(trendPrecision, trendPrecision-magnitude))
+		slopePerDay := roundTo(*s.SlopePerDay, precision)
+		s.SlopePerDay = &slopePerDay
+	}
+	s.First = roundTo(s.First, trendPrecision)
+	s.Last = roundTo(s.Last, trendPrecision)
+	s.ChangeSinceFirst = roundTo(s.ChangeSinceFirst, trendPrecision)
+}
@@ -0,0 +1,110 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+)
+
+var ErrInvalidUnits = errors.New("invalid units")
+
+// Units represents the units of the measured values, the keys are the values json names. The values which are not
+// listed are in SI units.
+type Units map[string]string
+
+// measurement describes the units a value can be measured in.
+type measurement struct {
+	// si is the canonical unit, all the values are stored in it
+	si string
+	// us is the conventional (US customary) unit
+	us string
+	// factor converts the value in the conventional unit to the canonical one
+	factor float64
+	// siPrecision and usPrecision are the numbers of decimal places of the value in the canonical and the
+	// conventional units
+	siPrecision int
+	usPrecision int
+}
+
+var (
+	measurementCholesterol = measurement{
+		si: common.UnitMillimolesPerLiter, us: common.UnitMilligramsPerDeciliter, factor: 1 / 38.67, siPrecision: 2, usPrecision: 0,
+	}
+	measurementTriglycerides = measurement{
+		si: common.UnitMillimolesPerLiter, us: common.UnitMilligramsPerDeciliter, factor: 1 / 88.57, siPrecision: 2, usPrecision: 0,
+	}
+	measurementLipoprotein = measurement{
+		si: common.UnitGramsPerLiter, us: common.UnitMilligramsPerDeciliter, factor: 0.01, siPrecision: 2, usPrecision: 0,
+	}
+	measurementCReactiveProtein = measurement{
+		si: common.UnitMilligramsPerLiter, us: common.UnitMilligramsPerDeciliter, factor: 10, siPrecision: 1, usPrecision: 2,
+	}
+	measurementCreatinine = measurement{
+		si: common.UnitMicromolesPerLiter, us: common.UnitMilligramsPerDeciliter, factor: 88.4, siPrecision: 1, usPrecision: 2,
+	}
+	measurementGlucose = measurement{
+		si: common.UnitMillimolesPerLiter, us: common.UnitMilligramsPerDeciliter, factor: 1 / 18.016, siPrecision: 1, usPrecision: 0,
+	}
+	measurementGlycatedHemoglobin = measurement{
+		si: common.UnitPercent, us: common.UnitPercent, factor: 1, siPrecision: 1, usPrecision: 1,
+	}
+	measurementWeight = measurement{
+		si: common.UnitKilogram, us: common.UnitPound, factor: 0.45359237, siPrecision: 1, usPrecision: 1,
+	}
+	measurementLength = measurement{
+		si: common.UnitCentimeter, us: common.UnitInch, factor: 2.54, siPrecision: 1, usPrecision: 1,
+	}
+)
+
+// measuredValue binds the value (the pointer to the structure field) to the units it can be measured in.
+type measuredValue struct {
+	value       **float64
+	measurement measurement
+}
+
+// set replaces the value instead of modifying it in place, because the value may be shared with other structures.
+func (v measuredValue) set(value float64) {
+	*v.value = &value
+}
+
+// normalizeUnits converts the values declared in the conventional units to the canonical (SI) ones.
+func normalizeUnits(units Units, values map[string]measuredValue) error {
+	for valueName, unit := range units {
+		value, found := values[valueName]
+		if !found {
+			return fmt.Errorf("%w: %v can't be measured", ErrInvalidUnits, valueName)
+		}
+
+		switch unit {
+		case value.measurement.si:
+			continue
+		case value.measurement.us:
+			if *value.value != nil {
+				value.set(roundTo(**value.value*value.measurement.factor, value.measurement.siPrecision))
+			}
+		default:
+			return fmt.Errorf("%w: %v can't be measured in %v", ErrInvalidUnits, valueName, unit)
+		}
+	}
+	return nil
+}
+
+// convertUnits converts the canonical (SI) values to the unit system and returns the units of the values.
+func convertUnits(unitSystem string, values map[string]measuredValue) Units {
+	units := make(Units, len(values))
+	for valueName, value := range values {
+		if *value.value == nil {
+			continue
+		}
+
+		if unitSystem != common.UnitSystemUS {
+			units[valueName] = value.measurement.si
+			continue
+		}
+
+		value.set(roundTo(**value.value/value.measurement.factor, value.measurement.usPrecision))
+		units[valueName] = value.measurement.us
+	}
+	return units
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+// check whether ActivityService structure implements the service.ActivityService interface
+var _ service.ActivityService = (*ActivityService)(nil)
+
+// ActivityService implements service.ActivityService interface.
+type ActivityService struct {
+	activity storage.ActivityRepository
+}
+
+func NewActivityService(activity storage.ActivityRepository) *ActivityService {
+	return &ActivityService{
+		activity: activity,
+	}
+}
+
+func (s *ActivityService) Compendium() []*domain.ActivityType {
+	return domain.ActivityCompendium()
+}
+
+func (s *ActivityService) Create(entry domain.ActivityEntry) error {
+	if err := entry.Validate(); err != nil {
+		return err
+	}
+
+	if entry.PerformedAt.IsZero() {
+		entry.PerformedAt.Time = time.Now()
+	}
+	// the MET value is always taken from the compendium
+	entry.MET = 0
+	entry.Evaluate()
+
+	return s.activity.Create(entry)
+}
+
+func (s *ActivityService) Delete(id, userID uint64) error {
+	if err := s.activity.Delete(id, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrActivityEntryNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *ActivityService) FindAll(criteria domain.ActivityLogCriteria) ([]*domain.ActivityEntry, error) {
+	if err := criteria.Validate(); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.activity.FindAll(criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		entry.Evaluate()
+	}
+
+	return entries, nil
+}
+
+func (s *ActivityService) WeeklyProgress(criteria domain.ActivityLogCriteria) ([]*domain.ActivityWeeklyProgress, error) {
+	if err := criteria.Validate(); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.activity.FindAll(criteria.WholeWeeks())
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ActivityWeeklyProgresses(entries), nil
+}
+
+func (s *ActivityService) RecentSummary(userID uint64) (*domain.ActivitySummary, error) {
+	now := time.Now()
+
+	entries, err := s.activity.FindAll(domain.ActivityLogCriteria{
+		UserID: userID,
+		From:   now.AddDate(0, 0, -7),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.SummarizeRecentActivity(entries, now), nil
+}
@@ -1,12 +1,16 @@
 package service
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 
+	"github.com/cardio-analyst/backend/internal/gateway/config"
 	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/client"
 	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
 	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
 )
 
 // check whether AnalysisService structure implements the service.AnalysisService interface
@@ -14,17 +18,42 @@ var _ service.AnalysisService = (*AnalysisService)(nil)
 
 // AnalysisService implements service.AnalysisService interface.
 type AnalysisService struct {
-	analyses storage.AnalysisRepository
+	referenceRanges []config.ReferenceRange
+
+	analyses        storage.AnalysisRepository
+	labTests        storage.LabTestRepository
+	basicIndicators storage.BasicIndicatorsRepository
+
+	authClient client.Auth
 }
 
-func NewAnalysisService(analyses storage.AnalysisRepository) *AnalysisService {
+func NewAnalysisService(
+	referenceRanges []config.ReferenceRange,
+	analyses storage.AnalysisRepository,
+	labTests storage.LabTestRepository,
+	basicIndicators storage.BasicIndicatorsRepository,
+	authClient client.Auth,
+) *AnalysisService {
 	return &AnalysisService{
-		analyses: analyses,
+		referenceRanges: referenceRanges,
+		analyses:        analyses,
+		labTests:        labTests,
+		basicIndicators: basicIndicators,
+		authClient:      authClient,
 	}
 }
 
 func (s *AnalysisService) Create(analysisData domain.Analysis) error {
-	if err := analysisData.Validate(false); err != nil {
+	if err := analysisData.NormalizeUnits(); err != nil {
+		return err
+	}
+
+	labTests, err := s.labTests.FindAll()
+	if err != nil {
+		return err
+	}
+
+	if err = analysisData.Validate(false, domain.LabTestsByCode(labTests)); err != nil {
 		return err
 	}
 
@@ -32,11 +61,20 @@ func (s *AnalysisService) Create(analysisData domain.Analysis) error {
 }
 
 func (s *AnalysisService) Update(analysisData domain.Analysis) error {
-	if err := analysisData.Validate(true); err != nil {
+	if err := analysisData.NormalizeUnits(); err != nil {
+		return err
+	}
+
+	labTests, err := s.labTests.FindAll()
+	if err != nil {
+		return err
+	}
+
+	if err = analysisData.Validate(true, domain.LabTestsByCode(labTests)); err != nil {
 		return err
 	}
 
-	_, err := s.analyses.Get(analysisData.ID, analysisData.UserID)
+	_, err = s.analyses.Get(analysisData.ID, analysisData.UserID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domain.ErrAnalysisRecordNotFound
@@ -48,5 +86,95 @@ func (s *AnalysisService) Update(analysisData domain.Analysis) error {
 }
 
 func (s *AnalysisService) FindAll(userID uint64) ([]*domain.Analysis, error) {
-	return s.analyses.FindAll(userID)
+	analyses, err := s.analyses.FindAll(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.prepare(userID, analyses); err != nil {
+		return nil, err
+	}
+
+	return analyses, nil
+}
+
+func (s *AnalysisService) Find(criteria domain.RecordsCriteria) ([]*domain.Analysis, uint64, error) {
+	if err := criteria.Validate(); err != nil {
+		return nil, 0, err
+	}
+
+	analyses, nextCursor, err := s.analyses.Find(criteria)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err = s.prepare(criteria.UserID, analyses); err != nil {
+		return nil, 0, err
+	}
+
+	return analyses, nextCursor, nil
+}
+
+// prepare calculates the derived values of the user analyses and flags the values according to the reference ranges.
+func (s *AnalysisService) prepare(userID uint64, analyses []*domain.Analysis) error {
+	if len(analyses) == 0 {
+		return nil
+	}
+
+	basicIndicators, err := s.basicIndicators.FindAll(userID)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.authClient.GetUser(context.TODO(), model.UserCriteria{
+		ID: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	// only gender is used, so the blood pressure diary is not needed
+	gender := domain.ExtractScoreDataFrom(basicIndicators, nil).Gender
+
+	domain.DeriveAnalyses(analyses, basicIndicators, gender, user)
+
+	for _, analysis := range analyses {
+		// the reference ranges are applied by the age at the collection
+		age := user.AgeAt(analysis.CreatedAt.Time)
+		for valueName, value := range analysis.Values() {
+			status, found := referenceStatus(s.referenceRanges, valueName, value, gender, age)
+			if !found {
+				continue
+			}
+
+			if analysis.Flags == nil {
+				analysis.Flags = make(map[string]string)
+			}
+			analysis.Flags[valueName] = status
+		}
+	}
+
+	return nil
+}
+
+func (s *AnalysisService) Delete(id, userID uint64) error {
+	if err := s.analyses.Delete(id, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrAnalysisRecordNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *AnalysisService) History(id, userID uint64) ([]*domain.AnalysisRevision, error) {
+	_, err := s.analyses.Get(id, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAnalysisRecordNotFound
+		}
+		return nil, err
+	}
+
+	return s.analyses.History(id, userID)
 }
@@ -24,18 +24,30 @@ func NewBasicIndicatorsService(basicIndicators storage.BasicIndicatorsRepository
 }
 
 func (s *BasicIndicatorsService) Create(basicIndicatorsData domain.BasicIndicators) error {
+	if err := basicIndicatorsData.NormalizeUnits(); err != nil {
+		return err
+	}
+
 	if err := basicIndicatorsData.Validate(false); err != nil {
 		return err
 	}
 
+	basicIndicatorsData.Classify()
+
 	return s.basicIndicators.Save(basicIndicatorsData)
 }
 
 func (s *BasicIndicatorsService) Update(basicIndicatorsData domain.BasicIndicators) error {
+	if err := basicIndicatorsData.NormalizeUnits(); err != nil {
+		return err
+	}
+
 	if err := basicIndicatorsData.Validate(true); err != nil {
 		return err
 	}
 
+	basicIndicatorsData.Classify()
+
 	_, err := s.basicIndicators.Get(basicIndicatorsData.ID, basicIndicatorsData.UserID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -50,3 +62,33 @@ func (s *BasicIndicatorsService) Update(basicIndicatorsData domain.BasicIndicato
 func (s *BasicIndicatorsService) FindAll(userID uint64) ([]*domain.BasicIndicators, error) {
 	return s.basicIndicators.FindAll(userID)
 }
+
+func (s *BasicIndicatorsService) Find(criteria domain.RecordsCriteria) ([]*domain.BasicIndicators, uint64, error) {
+	if err := criteria.Validate(); err != nil {
+		return nil, 0, err
+	}
+
+	return s.basicIndicators.Find(criteria)
+}
+
+func (s *BasicIndicatorsService) Delete(id, userID uint64) error {
+	if err := s.basicIndicators.Delete(id, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrBasicIndicatorsRecordNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *BasicIndicatorsService) History(id, userID uint64) ([]*domain.BasicIndicatorsRevision, error) {
+	_, err := s.basicIndicators.Get(id, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrBasicIndicatorsRecordNotFound
+		}
+		return nil, err
+	}
+
+	return s.basicIndicators.History(id, userID)
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+// check whether BloodPressureService structure implements the service.BloodPressureService interface
+var _ service.BloodPressureService = (*BloodPressureService)(nil)
+
+// BloodPressureService implements service.BloodPressureService interface.
+type BloodPressureService struct {
+	bloodPressure storage.BloodPressureRepository
+}
+
+func NewBloodPressureService(bloodPressure storage.BloodPressureRepository) *BloodPressureService {
+	return &BloodPressureService{
+		bloodPressure: bloodPressure,
+	}
+}
+
+func (s *BloodPressureService) Create(measurement domain.BloodPressureMeasurement) error {
+	if err := measurement.Validate(); err != nil {
+		return err
+	}
+
+	if measurement.MeasuredAt.IsZero() {
+		measurement.MeasuredAt.Time = time.Now()
+	}
+	measurement.ResolveTimeOfDay()
+	measurement.Classify()
+
+	return s.bloodPressure.Create(measurement)
+}
+
+func (s *BloodPressureService) Delete(id, userID uint64) error {
+	if err := s.bloodPressure.Delete(id, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrBloodPressureMeasurementNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *BloodPressureService) FindAll(criteria domain.BloodPressureDiaryCriteria) ([]*domain.BloodPressureMeasurement, error) {
+	if err := criteria.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.bloodPressure.FindAll(criteria)
+}
+
+func (s *BloodPressureService) WeeklyAverages(criteria domain.BloodPressureDiaryCriteria) ([]*domain.BloodPressureWeeklyAverage, error) {
+	if err := criteria.Validate(); err != nil {
+		return nil, err
+	}
+
+	measurements, err := s.bloodPressure.FindAll(criteria.WholeSeries())
+	if err != nil {
+		return nil, err
+	}
+
+	averages := make([]*domain.BloodPressureWeeklyAverage, 0)
+	for _, average := range domain.BloodPressureWeeklyAverages(measurements) {
+		if criteria.Covers(average) {
+			averages = append(averages, average)
+		}
+	}
+
+	return averages, nil
+}
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"time"
+
 	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
 	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
 	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
@@ -11,12 +13,14 @@ var _ service.DiseasesService = (*DiseasesService)(nil)
 
 // DiseasesService implements service.DiseasesService interface.
 type DiseasesService struct {
-	diseases storage.DiseasesRepository
+	diseases    storage.DiseasesRepository
+	medications storage.MedicationRepository
 }
 
-func NewDiseasesService(diseases storage.DiseasesRepository) *DiseasesService {
+func NewDiseasesService(diseases storage.DiseasesRepository, medications storage.MedicationRepository) *DiseasesService {
 	return &DiseasesService{
-		diseases: diseases,
+		diseases:    diseases,
+		medications: medications,
 	}
 }
 
@@ -26,9 +30,41 @@ func (s *DiseasesService) Get(userID uint64) (*domain.Diseases, error) {
 		return nil, err
 	}
 
+	medications, err := s.medications.FindAll(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the self-reported value is kept for the users who don't keep the medication list
+	if len(medications) > 0 {
+		diseases.TakesStatins = domain.TakesLipidLoweringMedications(medications, time.Now())
+	}
+
 	return diseases, nil
 }
 
 func (s *DiseasesService) Update(diseaseData domain.Diseases) error {
 	return s.diseases.Update(diseaseData)
 }
+
+func (s *DiseasesService) Revisions(userID uint64) ([]*domain.Revision, error) {
+	return s.diseases.Revisions(userID)
+}
+
+func (s *DiseasesService) RevisionsDiff(userID, fromID, toID uint64) (*domain.RevisionsDiff, error) {
+	revisions, err := s.diseases.Revisions(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return revisionsDiff(revisions, fromID, toID)
+}
+
+func (s *DiseasesService) Evolution(userID uint64) ([]*domain.RevisionEvolution, error) {
+	revisions, err := s.diseases.Revisions(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.RevisionsEvolution(revisions)
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+// check whether GlucoseService structure implements the service.GlucoseService interface
+var _ service.GlucoseService = (*GlucoseService)(nil)
+
+// GlucoseService implements service.GlucoseService interface.
+type GlucoseService struct {
+	glucose    storage.GlucoseRepository
+	labResults storage.LabResultRepository
+	diseases   service.DiseasesService
+
+	riskCategory service.RiskCategoryService
+}
+
+func NewGlucoseService(
+	glucose storage.GlucoseRepository,
+	labResults storage.LabResultRepository,
+	diseases service.DiseasesService,
+	riskCategory service.RiskCategoryService,
+) *GlucoseService {
+	return &GlucoseService{
+		glucose:      glucose,
+		labResults:   labResults,
+		diseases:     diseases,
+		riskCategory: riskCategory,
+	}
+}
+
+func (s *GlucoseService) Create(measurement domain.GlucoseMeasurement) error {
+	if err := measurement.Validate(); err != nil {
+		return err
+	}
+
+	if measurement.MeasuredAt.IsZero() {
+		measurement.MeasuredAt.Time = time.Now()
+	}
+
+	return s.glucose.Create(measurement)
+}
+
+func (s *GlucoseService) Delete(id, userID uint64) error {
+	if err := s.glucose.Delete(id, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrGlucoseMeasurementNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *GlucoseService) FindAll(criteria domain.GlucoseDiaryCriteria) ([]*domain.GlucoseMeasurement, error) {
+	if err := criteria.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.glucose.FindAll(criteria)
+}
+
+func (s *GlucoseService) Control(userID uint64) (*domain.GlycaemicControl, error) {
+	// the risk category is needed for the patients with diabetes only, they are always classified
+	riskCategory, err := s.riskCategory.Get(userID)
+	if err != nil && !errors.Is(err, domain.ErrNotEnoughDataToClassifyRisk) {
+		return nil, err
+	}
+
+	diseases, err := s.diseases.Get(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	measurements, err := s.glucose.FindAll(domain.GlucoseDiaryCriteria{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	labResults, err := s.labResults.FindAll(userID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.EvaluateGlycaemicControl(
+		riskCategory, diseases.HasTypeTwoDiabetes, domain.MergeGlycaemicLabResults(measurements, labResults), time.Now(),
+	), nil
+}
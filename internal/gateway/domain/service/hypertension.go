@@ -0,0 +1,42 @@
+package service
+
+import (
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+// check whether HypertensionService structure implements the service.HypertensionService interface
+var _ service.HypertensionService = (*HypertensionService)(nil)
+
+// HypertensionService implements service.HypertensionService interface.
+type HypertensionService struct {
+	hypertension storage.HypertensionRepository
+}
+
+func NewHypertensionService(hypertension storage.HypertensionRepository) *HypertensionService {
+	return &HypertensionService{
+		hypertension: hypertension,
+	}
+}
+
+func (s *HypertensionService) History(userID uint64) (*domain.HypertensionHistory, error) {
+	classifications, err := s.hypertension.FindAll(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, classification := range classifications {
+		classification.Grade = domain.HypertensionGrade(classification.Category, classification.Systolic)
+	}
+	domain.FlagHypertensionTransitions(classifications)
+
+	history := &domain.HypertensionHistory{
+		Classifications: classifications,
+	}
+	if len(classifications) > 0 {
+		history.Current = classifications[0]
+	}
+
+	return history, nil
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+// check whether LabTestsService structure implements the service.LabTestsService interface
+var _ service.LabTestsService = (*LabTestsService)(nil)
+
+// LabTestsService implements service.LabTestsService interface.
+type LabTestsService struct {
+	labTests storage.LabTestRepository
+}
+
+func NewLabTestsService(labTests storage.LabTestRepository) *LabTestsService {
+	return &LabTestsService{
+		labTests: labTests,
+	}
+}
+
+func (s *LabTestsService) Create(labTest domain.LabTest) error {
+	if err := labTest.Validate(); err != nil {
+		return err
+	}
+
+	return s.labTests.Create(labTest)
+}
+
+func (s *LabTestsService) Update(labTest domain.LabTest) error {
+	if _, err := s.get(labTest.Code); err != nil {
+		return err
+	}
+
+	if err := labTest.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.labTests.Update(labTest); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrLabTestNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *LabTestsService) Delete(code string) error {
+	labTest, err := s.get(code)
+	if err != nil {
+		return err
+	}
+
+	if labTest.BuiltIn {
+		return domain.ErrLabTestBuiltIn
+	}
+
+	if err = s.labTests.Delete(code); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrLabTestNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *LabTestsService) FindAll() ([]*domain.LabTest, error) {
+	return s.labTests.FindAll()
+}
+
+func (s *LabTestsService) get(code string) (*domain.LabTest, error) {
+	labTest, err := s.labTests.Get(code)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrLabTestNotFound
+		}
+		return nil, err
+	}
+	return labTest, nil
+}
+
+// check whether LabResultsService structure implements the service.LabResultsService interface
+var _ service.LabResultsService = (*LabResultsService)(nil)
+
+// LabResultsService implements service.LabResultsService interface.
+type LabResultsService struct {
+	labTests   storage.LabTestRepository
+	labResults storage.LabResultRepository
+}
+
+func NewLabResultsService(labTests storage.LabTestRepository, labResults storage.LabResultRepository) *LabResultsService {
+	return &LabResultsService{
+		labTests:   labTests,
+		labResults: labResults,
+	}
+}
+
+func (s *LabResultsService) Create(userID uint64, results []*domain.LabResult) error {
+	labTests, err := s.labTests.FindAll()
+	if err != nil {
+		return err
+	}
+
+	if err = domain.ValidateLabResults(results, domain.LabTestsByCode(labTests)); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, result := range results {
+		result.UserID = userID
+		if result.CollectedAt.IsZero() {
+			result.CollectedAt.Time = now
+		}
+	}
+
+	return s.labResults.Create(userID, results)
+}
+
+func (s *LabResultsService) FindAll(userID uint64, testCode string) ([]*domain.LabResult, error) {
+	return s.labResults.FindAll(userID, testCode)
+}
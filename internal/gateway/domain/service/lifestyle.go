@@ -32,3 +32,25 @@ func (s *LifestyleService) Get(userID uint64) (*domain.Lifestyle, error) {
 func (s *LifestyleService) Update(lifestyleData domain.Lifestyle) error {
 	return s.lifestyles.Update(lifestyleData)
 }
+
+func (s *LifestyleService) Revisions(userID uint64) ([]*domain.Revision, error) {
+	return s.lifestyles.Revisions(userID)
+}
+
+func (s *LifestyleService) RevisionsDiff(userID, fromID, toID uint64) (*domain.RevisionsDiff, error) {
+	revisions, err := s.lifestyles.Revisions(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return revisionsDiff(revisions, fromID, toID)
+}
+
+func (s *LifestyleService) Evolution(userID uint64) ([]*domain.RevisionEvolution, error) {
+	revisions, err := s.lifestyles.Revisions(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.RevisionsEvolution(revisions)
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
+)
+
+// check whether LipidManagementService structure implements the service.LipidManagementService interface
+var _ service.LipidManagementService = (*LipidManagementService)(nil)
+
+// LipidManagementService implements service.LipidManagementService interface.
+type LipidManagementService struct {
+	diseases service.DiseasesService
+
+	analyses     service.AnalysisService
+	riskCategory service.RiskCategoryService
+}
+
+func NewLipidManagementService(
+	diseases service.DiseasesService,
+	analyses service.AnalysisService,
+	riskCategory service.RiskCategoryService,
+) *LipidManagementService {
+	return &LipidManagementService{
+		diseases:     diseases,
+		analyses:     analyses,
+		riskCategory: riskCategory,
+	}
+}
+
+func (s *LipidManagementService) Get(userID uint64) (*domain.LipidManagement, error) {
+	riskCategory, err := s.riskCategory.Get(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	diseases, err := s.diseases.Get(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// LDL cholesterol may be estimated, so the analyses are taken with the derived values
+	analyses, err := s.analyses.FindAll(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.EvaluateLipidManagement(riskCategory, analyses, diseases.TakesStatins), nil
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+// check whether MedicationsService structure implements the service.MedicationsService interface
+var _ service.MedicationsService = (*MedicationsService)(nil)
+
+// MedicationsService implements service.MedicationsService interface.
+type MedicationsService struct {
+	medications storage.MedicationRepository
+	intakes     storage.MedicationIntakeRepository
+}
+
+func NewMedicationsService(medications storage.MedicationRepository, intakes storage.MedicationIntakeRepository) *MedicationsService {
+	return &MedicationsService{
+		medications: medications,
+		intakes:     intakes,
+	}
+}
+
+func (s *MedicationsService) Create(medication domain.Medication) error {
+	if err := medication.Validate(); err != nil {
+		return err
+	}
+
+	return s.medications.Create(medication)
+}
+
+func (s *MedicationsService) Update(medication domain.Medication) error {
+	if err := medication.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.medications.Update(medication); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrMedicationNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *MedicationsService) Delete(id, userID uint64) error {
+	if err := s.medications.Delete(id, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrMedicationNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *MedicationsService) FindAll(userID uint64) ([]*domain.Medication, error) {
+	medications, err := s.medications.FindAll(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, medication := range medications {
+		medication.Active = medication.ActiveOn(now)
+	}
+
+	return medications, nil
+}
+
+func (s *MedicationsService) CreateIntake(intake domain.MedicationIntake) error {
+	medication, err := s.medications.Get(intake.MedicationID, intake.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrMedicationNotFound
+		}
+		return err
+	}
+
+	now := time.Now()
+	if intake.TakenAt.IsZero() {
+		intake.TakenAt.Time = now
+	}
+
+	if err = intake.Validate(medication, now); err != nil {
+		return err
+	}
+
+	return s.intakes.Create(intake)
+}
+
+func (s *MedicationsService) DeleteIntake(id, userID uint64) error {
+	if err := s.intakes.Delete(id, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrMedicationIntakeNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *MedicationsService) FindIntakes(userID uint64, from, to time.Time) ([]*domain.MedicationIntake, error) {
+	return s.intakes.FindAll(userID, from, to)
+}
+
+func (s *MedicationsService) Adherence(criteria domain.MedicationAdherenceCriteria) (*domain.MedicationAdherence, error) {
+	if criteria.To.IsZero() {
+		criteria.To = time.Now()
+	}
+	if criteria.From.IsZero() {
+		criteria.From = criteria.To.AddDate(0, 0, 1-domain.DefaultAdherencePeriodDays)
+	}
+
+	if err := criteria.Validate(); err != nil {
+		return nil, err
+	}
+
+	medications, err := s.medications.FindAll(criteria.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	intakes, err := s.intakes.FindAll(criteria.UserID, criteria.From, criteria.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.CalculateMedicationAdherence(criteria, medications, intakes), nil
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"time"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+// check whether MetabolicSyndromeService structure implements the service.MetabolicSyndromeService interface
+var _ service.MetabolicSyndromeService = (*MetabolicSyndromeService)(nil)
+
+// MetabolicSyndromeService implements service.MetabolicSyndromeService interface.
+type MetabolicSyndromeService struct {
+	metabolicSyndrome storage.MetabolicSyndromeRepository
+	diseases          service.DiseasesService
+	basicIndicators   storage.BasicIndicatorsRepository
+	analyses          storage.AnalysisRepository
+	labResults        storage.LabResultRepository
+	glucose           storage.GlucoseRepository
+
+	bloodPressure service.BloodPressureService
+}
+
+func NewMetabolicSyndromeService(
+	metabolicSyndrome storage.MetabolicSyndromeRepository,
+	diseases service.DiseasesService,
+	basicIndicators storage.BasicIndicatorsRepository,
+	analyses storage.AnalysisRepository,
+	labResults storage.LabResultRepository,
+	glucose storage.GlucoseRepository,
+	bloodPressure service.BloodPressureService,
+) *MetabolicSyndromeService {
+	return &MetabolicSyndromeService{
+		metabolicSyndrome: metabolicSyndrome,
+		diseases:          diseases,
+		basicIndicators:   basicIndicators,
+		analyses:          analyses,
+		labResults:        labResults,
+		glucose:           glucose,
+		bloodPressure:     bloodPressure,
+	}
+}
+
+func (s *MetabolicSyndromeService) Evaluate(userID uint64) (*domain.MetabolicSyndromeScreening, error) {
+	diseases, err := s.diseases.Get(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	basicIndicators, err := s.basicIndicators.FindAll(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	analyses, err := s.analyses.FindAll(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	glucoseResults, err := s.labResults.FindAll(userID, common.LabTestGlucose)
+	if err != nil {
+		return nil, err
+	}
+
+	glucoseMeasurements, err := s.glucose.FindAll(domain.GlucoseDiaryCriteria{
+		UserID: userID,
+		Type:   common.GlucoseMeasurementFasting,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bloodPressureAverages, err := s.bloodPressure.WeeklyAverages(domain.BloodPressureDiaryCriteria{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	scoreData := domain.ExtractScoreDataFrom(basicIndicators, bloodPressureAverages)
+
+	screening := domain.ScreenMetabolicSyndrome(domain.NewMetabolicSyndromeData(
+		scoreData, bloodPressureAverages, diseases, basicIndicators, analyses,
+		domain.LatestFastingGlucose(glucoseMeasurements, glucoseResults),
+	))
+	screening.UserID = userID
+	screening.EvaluationDate.Time = time.Now()
+
+	return screening, nil
+}
+
+func (s *MetabolicSyndromeService) Screen(userID uint64) (*domain.MetabolicSyndromeScreening, error) {
+	screening, err := s.Evaluate(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the evaluation date column keeps the date only, so the repeated screenings of the day replace each other
+	if screening.ID, err = s.metabolicSyndrome.Save(*screening); err != nil {
+		return nil, err
+	}
+
+	return screening, nil
+}
+
+func (s *MetabolicSyndromeService) History(userID uint64) ([]*domain.MetabolicSyndromeScreening, error) {
+	return s.metabolicSyndrome.FindAll(userID)
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+// check whether PreferencesService structure implements the service.PreferencesService interface
+var _ service.PreferencesService = (*PreferencesService)(nil)
+
+// PreferencesService implements service.PreferencesService interface.
+type PreferencesService struct {
+	preferences storage.PreferencesRepository
+}
+
+func NewPreferencesService(preferences storage.PreferencesRepository) *PreferencesService {
+	return &PreferencesService{
+		preferences: preferences,
+	}
+}
+
+func (s *PreferencesService) Get(userID uint64) (*domain.Preferences, error) {
+	return s.preferences.Get(userID)
+}
+
+func (s *PreferencesService) Update(preferencesData domain.Preferences) error {
+	if err := preferencesData.Validate(); err != nil {
+		return err
+	}
+
+	// make sure the user preferences exist
+	if _, err := s.preferences.Get(preferencesData.UserID); err != nil {
+		return err
+	}
+
+	return s.preferences.Update(preferencesData)
+}
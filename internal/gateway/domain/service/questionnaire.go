@@ -1,6 +1,8 @@
 package service
 
 import (
+	"time"
+
 	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
 	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
 	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
@@ -29,10 +31,53 @@ func (s *QuestionnaireService) Get(userID uint64) (*domain.Questionnaire, error)
 	return questionnaire, nil
 }
 
-func (s *QuestionnaireService) UpdateAnginaRose(questionnaire domain.Questionnaire) error {
-	return s.repository.UpdateAnginaRose(questionnaire)
+func (s *QuestionnaireService) UpdateAnginaRose(userID uint64, answers domain.AnginaRoseAnswers) (*domain.AnginaRoseResult, error) {
+	if err := answers.Validate(); err != nil {
+		return nil, err
+	}
+
+	// the questionnaire is created on the first access, so the angina score is updated along with the result
+	if _, err := s.repository.Get(userID); err != nil {
+		return nil, err
+	}
+
+	result := domain.ClassifyAnginaRose(answers)
+	result.UserID = userID
+	result.CreatedAt.Time = time.Now()
+
+	if err := s.repository.SaveAnginaRose(result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s *QuestionnaireService) AnginaRoseResults(userID uint64) ([]*domain.AnginaRoseResult, error) {
+	return s.repository.FindAnginaRoseResults(userID)
 }
 
 func (s *QuestionnaireService) UpdateTreatmentAdherence(questionnaire domain.Questionnaire) error {
 	return s.repository.UpdateTreatmentAdherence(questionnaire)
 }
+
+func (s *QuestionnaireService) Revisions(userID uint64) ([]*domain.Revision, error) {
+	return s.repository.Revisions(userID)
+}
+
+func (s *QuestionnaireService) RevisionsDiff(userID, fromID, toID uint64) (*domain.RevisionsDiff, error) {
+	revisions, err := s.repository.Revisions(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return revisionsDiff(revisions, fromID, toID)
+}
+
+func (s *QuestionnaireService) Evolution(userID uint64) ([]*domain.RevisionEvolution, error) {
+	revisions, err := s.repository.Revisions(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.RevisionsEvolution(revisions)
+}
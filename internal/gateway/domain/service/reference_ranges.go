@@ -0,0 +1,38 @@
+package service
+
+import (
+	"github.com/cardio-analyst/backend/internal/gateway/config"
+)
+
+// referenceStatus returns the status of the value according to the first reference range matching the value name,
+// user gender and age. If there is no such range or band, false is returned.
+func referenceStatus(referenceRanges []config.ReferenceRange, valueName string, value float64, gender string, age int) (string, bool) {
+	for _, referenceRange := range referenceRanges {
+		if referenceRange.Value != valueName {
+			continue
+		}
+		if referenceRange.Gender != "" && referenceRange.Gender != gender {
+			continue
+		}
+		if referenceRange.AgeMin != 0 && age < referenceRange.AgeMin {
+			continue
+		}
+		if referenceRange.AgeMax != 0 && age > referenceRange.AgeMax {
+			continue
+		}
+
+		for _, band := range referenceRange.Bands {
+			if band.Min != nil && value < *band.Min {
+				continue
+			}
+			if band.Max != nil && value >= *band.Max {
+				continue
+			}
+			return band.Status, true
+		}
+
+		return "", false
+	}
+
+	return "", false
+}
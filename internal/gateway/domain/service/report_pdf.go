@@ -2,13 +2,16 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/jung-kurt/gofpdf"
 
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
 	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
 	"github.com/cardio-analyst/backend/internal/gateway/ports/client"
 	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
@@ -23,12 +26,83 @@ const (
 	reportUnicode = "cp1251"
 )
 
+// SCORE risk charts names by risk scope
+var riskScopeReportNames = map[string]string{
+	common.RiskScopeLow:      "Шкала для регионов низкого риска",
+	common.RiskScopeModerate: "Шкала для регионов умеренного риска",
+	common.RiskScopeHigh:     "Шкала для регионов высокого риска",
+	common.RiskScopeVeryHigh: "Шкала для регионов очень высокого риска",
+}
+
+// blood pressure categories names, see ESC/ESH guidelines
+var hypertensionCategoryReportNames = map[string]string{
+	common.HypertensionCategoryOptimal:          "оптимальное АД",
+	common.HypertensionCategoryNormal:           "нормальное АД",
+	common.HypertensionCategoryHighNormal:       "высокое нормальное АД",
+	common.HypertensionCategoryGrade1:           "АГ 1 степени",
+	common.HypertensionCategoryGrade2:           "АГ 2 степени",
+	common.HypertensionCategoryGrade3:           "АГ 3 степени",
+	common.HypertensionCategoryIsolatedSystolic: "изолированная систолическая АГ",
+}
+
+// blood pressure categories transitions names
+var hypertensionTransitionReportNames = map[string]string{
+	common.HypertensionTransitionWorsened: "ухудшение",
+	common.HypertensionTransitionImproved: "улучшение",
+}
+
+// metabolic syndrome screening statuses names
+var metabolicSyndromeStatusReportNames = map[string]string{
+	common.MetabolicSyndromePresent:       "выявлен",
+	common.MetabolicSyndromeAbsent:        "не выявлен",
+	common.MetabolicSyndromeIndeterminate: "не определён",
+}
+
+// glycaemic control indicators statuses names and the reference statuses they are coloured as
+var (
+	glycaemicStatusReportNames = map[string]string{
+		common.GlycaemicStatusOnTarget:      "цель достигнута",
+		common.GlycaemicStatusAboveTarget:   "выше цели",
+		common.GlycaemicStatusHypoglycaemia: "гипогликемия",
+	}
+	glycaemicStatusReferenceStatuses = map[string]string{
+		common.GlycaemicStatusOnTarget:      common.ReferenceStatusNormal,
+		common.GlycaemicStatusAboveTarget:   common.ReferenceStatusHigh,
+		common.GlycaemicStatusHypoglycaemia: common.ReferenceStatusLow,
+	}
+)
+
+// analyses values reference statuses names and colors (RGB)
+var (
+	referenceStatusReportNames = map[string]string{
+		common.ReferenceStatusLow:        "ниже нормы",
+		common.ReferenceStatusNormal:     "норма",
+		common.ReferenceStatusBorderline: "пограничное значение",
+		common.ReferenceStatusHigh:       "выше нормы",
+		common.ReferenceStatusCritical:   "критическое значение",
+	}
+	referenceStatusColors = map[string][3]int{
+		common.ReferenceStatusLow:        {0, 90, 200},
+		common.ReferenceStatusNormal:     {0, 128, 0},
+		common.ReferenceStatusBorderline: {230, 140, 0},
+		common.ReferenceStatusHigh:       {210, 0, 0},
+		common.ReferenceStatusCritical:   {140, 0, 0},
+	}
+)
+
 var _ service.ReportService = (*PDFReportService)(nil)
 
 type PDFReportService struct {
-	recommendations service.RecommendationsService
+	recommendations   service.RecommendationsService
+	analyses          service.AnalysisService
+	labTests          service.LabTestsService
+	labResults        service.LabResultsService
+	bloodPressure     service.BloodPressureService
+	hypertension      service.HypertensionService
+	riskCategory      service.RiskCategoryService
+	metabolicSyndrome service.MetabolicSyndromeService
+	glucose           service.GlucoseService
 
-	analyses        storage.AnalysisRepository
 	basicIndicators storage.BasicIndicatorsRepository
 
 	authClient client.Auth
@@ -36,15 +110,29 @@ type PDFReportService struct {
 
 func NewPDFReportService(
 	recommendations service.RecommendationsService,
-	analyses storage.AnalysisRepository,
+	analyses service.AnalysisService,
+	labTests service.LabTestsService,
+	labResults service.LabResultsService,
+	bloodPressure service.BloodPressureService,
+	hypertension service.HypertensionService,
+	riskCategory service.RiskCategoryService,
+	metabolicSyndrome service.MetabolicSyndromeService,
+	glucose service.GlucoseService,
 	basicIndicators storage.BasicIndicatorsRepository,
 	authClient client.Auth,
 ) *PDFReportService {
 	return &PDFReportService{
-		recommendations: recommendations,
-		analyses:        analyses,
-		basicIndicators: basicIndicators,
-		authClient:      authClient,
+		recommendations:   recommendations,
+		analyses:          analyses,
+		labTests:          labTests,
+		labResults:        labResults,
+		bloodPressure:     bloodPressure,
+		hypertension:      hypertension,
+		riskCategory:      riskCategory,
+		metabolicSyndrome: metabolicSyndrome,
+		glucose:           glucose,
+		basicIndicators:   basicIndicators,
+		authClient:        authClient,
 	}
 }
 
@@ -79,13 +167,19 @@ func (s *PDFReportService) GenerateReport(userID uint64) (string, error) {
 		return "", err
 	}
 
+	var diabetesDataExists bool
+	diabetesDataExists, err = s.fillDiabetesReportData(userID, pdf, writeToHTML)
+	if err != nil {
+		return "", err
+	}
+
 	var recommendationsDataExists bool
 	recommendationsDataExists, err = s.fillRecommendationsReportData(userID, pdf, writeToHTML)
 	if err != nil {
 		return "", err
 	}
 
-	if !basicIndicatorsDataExists && !analysesDataExists && !recommendationsDataExists {
+	if !basicIndicatorsDataExists && !analysesDataExists && !diabetesDataExists && !recommendationsDataExists {
 		pdf.Close()
 		return "", domain.ErrNotEnoughDataToCompileReport
 	}
@@ -115,8 +209,19 @@ func (s *PDFReportService) fillBasicIndicatorsReportData(userID uint64, pdf *gof
 		return false, err
 	}
 
-	scoreData := domain.ExtractScoreDataFrom(basicIndicators)
+	analyses, err := s.analyses.FindAll(userID)
+	if err != nil {
+		return false, err
+	}
+
+	bloodPressureAverages, err := s.bloodPressure.WeeklyAverages(domain.BloodPressureDiaryCriteria{UserID: userID})
+	if err != nil {
+		return false, err
+	}
+
+	scoreData := domain.ExtractScoreDataFrom(basicIndicators, bloodPressureAverages)
 	scoreData.Age = user.Age()
+	scoreData.HDLCholesterolLevel = domain.ExtractHDLCholesterolLevelFrom(analyses)
 
 	weight, height, waistSize, bodyMassIndex := extractBMIIndications(basicIndicators)
 
@@ -145,15 +250,44 @@ func (s *PDFReportService) fillBasicIndicatorsReportData(userID uint64, pdf *gof
 
 	generateRow("Уровень систолического АД (мм.рт.ст.)", fmt.Sprintf("%.1f", scoreData.SBPLevel), pdf, writeToHTML)
 
+	hypertensionHistory, err := s.hypertension.History(userID)
+	if err != nil {
+		return false, err
+	}
+
+	if current := hypertensionHistory.Current; current != nil {
+		hypertensionCategoryStr := hypertensionCategoryReportNames[current.Category]
+		if transitionName, found := hypertensionTransitionReportNames[current.Transition]; found {
+			hypertensionCategoryStr += fmt.Sprintf(
+				" (%v, ранее: %v)", transitionName, hypertensionCategoryReportNames[current.PreviousCategory],
+			)
+		}
+
+		generateRow("Категория АД по классификации ESC/ESH", hypertensionCategoryStr, pdf, writeToHTML)
+	}
+
 	generateRow("Общий холестерин (ммоль/л)", fmt.Sprintf("%.1f", scoreData.TotalCholesterolLevel), pdf, writeToHTML)
 
+	nonHDLCholesterolLevel, approximate := scoreData.NonHDLCholesterolLevel()
+	nonHDLCholesterolLevelStr := fmt.Sprintf("%.1f", nonHDLCholesterolLevel)
+	if approximate {
+		nonHDLCholesterolLevelStr += " (приблизительно: ЛПВП неизвестен, использован общий холестерин)"
+	}
+
+	generateRow("Холестерин, не входящий в состав ЛПВП (ммоль/л)", nonHDLCholesterolLevelStr, pdf, writeToHTML)
+
 	var (
-		cvEventsRiskValue            int64
+		cvEventsRiskValue            float64
 		idealCardiovascularAgesRange string
+		riskScope                    string
 	)
 	for _, indicators := range basicIndicators {
 		if indicators.CVEventsRiskValue != nil && cvEventsRiskValue == 0 {
 			cvEventsRiskValue = *indicators.CVEventsRiskValue
+			// the risk scope describes the chart which produced exactly this risk value
+			if indicators.RiskScope != nil {
+				riskScope = *indicators.RiskScope
+			}
 		}
 		if indicators.IdealCardiovascularAgesRange != nil && idealCardiovascularAgesRange == "" {
 			idealCardiovascularAgesRange = *indicators.IdealCardiovascularAgesRange
@@ -166,15 +300,57 @@ func (s *PDFReportService) fillBasicIndicatorsReportData(userID uint64, pdf *gof
 	}
 
 	generateRow(
-		"Риск сердечно-сосудистых событий<br></br>в течение 10 лет по шкале SCORE", fmt.Sprintf("%d", cvEventsRiskValue)+"%",
+		"Риск сердечно-сосудистых событий<br></br>в течение 10 лет по шкале SCORE", fmt.Sprintf("%.1f", cvEventsRiskValue)+"%",
 		pdf, writeToHTML,
 	)
 
+	if riskScopeName, found := riskScopeReportNames[riskScope]; found {
+		generateRow("Шкала SCORE, по которой рассчитан риск", riskScopeName, pdf, writeToHTML)
+	}
+
 	generateRow("Ваш «сердечно-сосудистый возраст»", idealCardiovascularAgesRange, pdf, writeToHTML)
 
+	riskCategory, err := s.riskCategory.Get(userID)
+	if err != nil && !errors.Is(err, domain.ErrNotEnoughDataToClassifyRisk) {
+		return false, err
+	}
+	if riskCategory != nil {
+		generateRow(
+			"Категория сердечно-сосудистого риска<br></br>по критериям ESC", riskCategory.Name+" ("+riskCategory.Reason+")",
+			pdf, writeToHTML,
+		)
+	}
+
+	metabolicSyndrome, err := s.metabolicSyndrome.Evaluate(userID)
+	if err != nil {
+		return false, err
+	}
+
+	generateRow(
+		"Метаболический синдром<br></br>по критериям NCEP ATP III и IDF", metabolicSyndromeToString(metabolicSyndrome),
+		pdf, writeToHTML,
+	)
+
 	return true, nil
 }
 
+// metabolicSyndromeToString describes the screening status with the met criteria and the missing data.
+func metabolicSyndromeToString(screening *domain.MetabolicSyndromeScreening) string {
+	details := make([]string, 0, 2)
+	if criteria := screening.MetCriteriaToString(); criteria != "" {
+		details = append(details, "выполнены критерии: "+criteria)
+	}
+	if len(screening.MissingData) > 0 {
+		details = append(details, "не хватает данных: "+screening.MissingDataToString())
+	}
+
+	description := metabolicSyndromeStatusReportNames[screening.Status]
+	if len(details) > 0 {
+		description += " (" + strings.Join(details, "; ") + ")"
+	}
+	return description
+}
+
 func (s *PDFReportService) fillAnalysesReportData(userID uint64, pdf *gofpdf.Fpdf, writeToHTML func(value string)) (bool, error) {
 	analyses, err := s.analyses.FindAll(userID)
 	if err != nil {
@@ -184,6 +360,11 @@ func (s *PDFReportService) fillAnalysesReportData(userID uint64, pdf *gofpdf.Fpd
 		return false, nil
 	}
 
+	otherLabResults, err := s.otherLabResults(userID)
+	if err != nil {
+		return false, err
+	}
+
 	pdf.AddPage()
 	pdf.SetTextColor(0, 0, 0)
 	pdf.SetFontSize(18)
@@ -202,40 +383,66 @@ func (s *PDFReportService) fillAnalysesReportData(userID uint64, pdf *gofpdf.Fpd
 		pdf.SetTextColor(0, 0, 0)
 
 		if analysis.HighDensityCholesterol != nil {
-			generateRow(
+			generateAnalysisRow(
 				"Холестерин высокой плотности (ЛПВП) (ммоль/л)", fmt.Sprintf("%.1f", *analysis.HighDensityCholesterol),
-				pdf, writeToHTML,
+				analysis.Flags[common.AnalysisValueHighDensityCholesterol], pdf, writeToHTML,
 			)
 		}
 
 		if analysis.LowDensityCholesterol != nil {
-			generateRow(
-				"Холестерин низкой плотности (ЛПНП) (ммоль/л)", fmt.Sprintf("%.1f", *analysis.LowDensityCholesterol),
-				pdf, writeToHTML,
+			generateAnalysisRow(
+				"Холестерин низкой плотности (ЛПНП) (ммоль/л)",
+				computedValueToString(analysis, common.AnalysisValueLowDensityCholesterol, *analysis.LowDensityCholesterol, 1),
+				analysis.Flags[common.AnalysisValueLowDensityCholesterol], pdf, writeToHTML,
 			)
 		}
 
 		if analysis.Triglycerides != nil {
-			generateRow("Триглицериды (ммоль/л)", fmt.Sprintf("%.1f", *analysis.Triglycerides), pdf, writeToHTML)
+			generateAnalysisRow(
+				"Триглицериды (ммоль/л)", fmt.Sprintf("%.1f", *analysis.Triglycerides),
+				analysis.Flags[common.AnalysisValueTriglycerides], pdf, writeToHTML,
+			)
 		}
 
 		if analysis.Lipoprotein != nil {
-			generateRow("Липопротеин (г/л)", fmt.Sprintf("%.1f", *analysis.Lipoprotein), pdf, writeToHTML)
+			generateAnalysisRow(
+				"Липопротеин (г/л)", fmt.Sprintf("%.1f", *analysis.Lipoprotein),
+				analysis.Flags[common.AnalysisValueLipoprotein], pdf, writeToHTML,
+			)
 		}
 
 		if analysis.HighlySensitiveCReactiveProtein != nil {
-			generateRow(
+			generateAnalysisRow(
 				"Высокочувствительный С-реактивный белок (кардио) (мг/л)", fmt.Sprintf("%.1f", *analysis.HighlySensitiveCReactiveProtein),
-				pdf, writeToHTML,
+				analysis.Flags[common.AnalysisValueHighlySensitiveCReactiveProtein], pdf, writeToHTML,
 			)
 		}
 
 		if analysis.AtherogenicityCoefficient != nil {
-			generateRow("Коэффициент атерогенности", fmt.Sprintf("%.1f", *analysis.AtherogenicityCoefficient), pdf, writeToHTML)
+			generateAnalysisRow(
+				"Коэффициент атерогенности",
+				computedValueToString(analysis, common.AnalysisValueAtherogenicityCoefficient, *analysis.AtherogenicityCoefficient, 1),
+				analysis.Flags[common.AnalysisValueAtherogenicityCoefficient], pdf, writeToHTML,
+			)
 		}
 
 		if analysis.Creatinine != nil {
-			generateRow("Креатинин (ммоль/л)", fmt.Sprintf("%.1f", *analysis.Creatinine), pdf, writeToHTML)
+			generateAnalysisRow(
+				"Креатинин (мкмоль/л)", fmt.Sprintf("%.1f", *analysis.Creatinine),
+				analysis.Flags[common.AnalysisValueCreatinine], pdf, writeToHTML,
+			)
+		}
+
+		if analysis.EstimatedGlomerularFiltrationRate != nil {
+			generateAnalysisRow(
+				"Скорость клубочковой фильтрации, CKD-EPI (мл/мин/1,73 м2)",
+				computedValueToString(analysis, common.AnalysisValueEstimatedGlomerularFiltrationRate, *analysis.EstimatedGlomerularFiltrationRate, 0),
+				analysis.Flags[common.AnalysisValueEstimatedGlomerularFiltrationRate], pdf, writeToHTML,
+			)
+		}
+
+		for _, labResult := range otherLabResults[analysis.ID] {
+			generateRow(labResult.label, strconv.FormatFloat(labResult.Value, 'f', -1, 64), pdf, writeToHTML)
 		}
 
 		if analysis.AtheroscleroticPlaquesPresence != nil {
@@ -258,6 +465,71 @@ func (s *PDFReportService) fillAnalysesReportData(userID uint64, pdf *gofpdf.Fpd
 	return true, nil
 }
 
+type reportLabResult struct {
+	*domain.LabResult
+	label string
+}
+
+// otherLabResults returns the lab results of the tests which are not the part of the analyses fixed values grouped
+// by the analyses.
+func (s *PDFReportService) otherLabResults(userID uint64) (map[uint64][]reportLabResult, error) {
+	labTests, err := s.labTests.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	labTestsByCode := domain.LabTestsByCode(labTests)
+
+	labResults, err := s.labResults.FindAll(userID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	otherLabResults := make(map[uint64][]reportLabResult)
+	for _, labResult := range labResults {
+		labTest, found := labTestsByCode[labResult.TestCode]
+		if !found || labTest.BuiltIn {
+			continue
+		}
+
+		label := labTest.Name
+		if labResult.Unit != "" {
+			label = fmt.Sprintf("%v (%v)", label, labResult.Unit)
+		}
+
+		otherLabResults[labResult.AnalysisID] = append(otherLabResults[labResult.AnalysisID], reportLabResult{
+			LabResult: labResult,
+			label:     label,
+		})
+	}
+
+	return otherLabResults, nil
+}
+
+// computedValueToString formats the analysis value and marks it if it is derived from the other values.
+func computedValueToString(analysis *domain.Analysis, valueName string, value float64, precision int) string {
+	valueStr := strconv.FormatFloat(value, 'f', precision, 64)
+	if analysis.IsComputed(valueName) {
+		valueStr += " (рассчитано)"
+	}
+	return valueStr
+}
+
+// generateAnalysisRow generates the row with the value coloured according to its reference status.
+func generateAnalysisRow(label, value, status string, pdf *gofpdf.Fpdf, htmlWhite func(value string)) {
+	pdf.SetTextColor(0, 0, 0)
+	htmlWhite(fmt.Sprintf(`<p>%s</p>: `, label))
+
+	color, found := referenceStatusColors[status]
+	if !found {
+		pdf.SetTextColor(89, 89, 89)
+		htmlWhite(fmt.Sprintf(`<p>%s</p><br></br><br></br>`, value))
+		return
+	}
+
+	pdf.SetTextColor(color[0], color[1], color[2])
+	htmlWhite(fmt.Sprintf(`<p>%s (%s)</p><br></br><br></br>`, value, referenceStatusReportNames[status]))
+}
+
 func generateRow(label string, value string, pdf *gofpdf.Fpdf, htmlWhite func(value string)) {
 	pdf.SetTextColor(0, 0, 0)
 	htmlWhite(fmt.Sprintf(`<p>%s</p>: `, label))
@@ -266,8 +538,81 @@ func generateRow(label string, value string, pdf *gofpdf.Fpdf, htmlWhite func(va
 	htmlWhite(fmt.Sprintf(`<p>%s</p><br></br><br></br>`, value))
 }
 
+func (s *PDFReportService) fillDiabetesReportData(userID uint64, pdf *gofpdf.Fpdf, writeToHTML func(value string)) (bool, error) {
+	control, err := s.glucose.Control(userID)
+	if err != nil {
+		return false, err
+	}
+
+	var measured bool
+	for _, indicator := range control.Indicators {
+		if indicator.Value != nil {
+			measured = true
+			break
+		}
+	}
+	if !control.HasTypeTwoDiabetes && !measured {
+		return false, nil
+	}
+
+	pdf.AddPage()
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetFontSize(18)
+
+	title := "<h2>Контроль гликемии</h2><br></br><br></br>"
+	writeToHTML(title)
+
+	pdf.SetFontSize(16)
+
+	if control.HasTypeTwoDiabetes {
+		description := "есть"
+		if control.RiskCategory != nil {
+			description += ", цели лечения установлены с учётом категории сердечно-сосудистого риска (" +
+				control.RiskCategory.Name + ")"
+		}
+		generateRow("Сахарный диабет 2 типа", description, pdf, writeToHTML)
+	} else {
+		generateRow("Сахарный диабет 2 типа", "нет, показатели сравниваются с нормой", pdf, writeToHTML)
+	}
+
+	for _, indicator := range control.Indicators {
+		label := fmt.Sprintf("%s (%s)", indicator.Name, indicator.Unit)
+		target := fmt.Sprintf("%s – менее %.1f", control.TargetName(), indicator.Target)
+		if indicator.Value == nil {
+			generateRow(label, "нет данных ("+target+")", pdf, writeToHTML)
+			continue
+		}
+
+		value := fmt.Sprintf("%.1f", *indicator.Value)
+		if indicator.MeasuredAt != nil {
+			value += " от " + indicator.MeasuredAt.Format("02.01.2006")
+		}
+		generateGlycaemicIndicatorRow(label, value+", "+target, indicator.Status, pdf, writeToHTML)
+	}
+
+	if control.RecentHypoglycaemiaCount > 0 {
+		generateRow(
+			"Эпизоды гипогликемии за последние 30 дней", strconv.Itoa(control.RecentHypoglycaemiaCount),
+			pdf, writeToHTML,
+		)
+	}
+
+	return true, nil
+}
+
+// generateGlycaemicIndicatorRow generates the row with the value coloured according to its target attainment status.
+func generateGlycaemicIndicatorRow(label, value, status string, pdf *gofpdf.Fpdf, htmlWhite func(value string)) {
+	color := referenceStatusColors[glycaemicStatusReferenceStatuses[status]]
+
+	pdf.SetTextColor(0, 0, 0)
+	htmlWhite(fmt.Sprintf(`<p>%s</p>: `, label))
+
+	pdf.SetTextColor(color[0], color[1], color[2])
+	htmlWhite(fmt.Sprintf(`<p>%s (%s)</p><br></br><br></br>`, value, glycaemicStatusReportNames[status]))
+}
+
 func (s *PDFReportService) fillRecommendationsReportData(userID uint64, pdf *gofpdf.Fpdf, htmlWrite func(value string)) (bool, error) {
-	recommendations, err := s.recommendations.GetRecommendations(userID)
+	recommendations, err := s.recommendations.GetRecommendations(userID, 0)
 	if err != nil {
 		return false, err
 	}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+)
+
+// revisionsDiff returns the changes of the user record between the revisions sorted from the newest to the oldest.
+// The latest revision is used if toID is 0 and the revision preceding the to one is used if fromID is 0.
+func revisionsDiff(revisions []*domain.Revision, fromID, toID uint64) (*domain.RevisionsDiff, error) {
+	toIndex := -1
+	for i, revision := range revisions {
+		if toID == 0 || revision.ID == toID {
+			toIndex = i
+			break
+		}
+	}
+	if toIndex < 0 {
+		return nil, domain.ErrRevisionNotFound
+	}
+
+	var from *domain.Revision
+	if fromID == 0 {
+		if toIndex+1 < len(revisions) {
+			from = revisions[toIndex+1]
+		}
+	} else {
+		for _, revision := range revisions {
+			if revision.ID == fromID {
+				from = revision
+				break
+			}
+		}
+		if from == nil {
+			return nil, domain.ErrRevisionNotFound
+		}
+	}
+
+	return domain.DiffRevisions(from, revisions[toIndex])
+}
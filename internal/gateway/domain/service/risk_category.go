@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/client"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+// check whether RiskCategoryService structure implements the service.RiskCategoryService interface
+var _ service.RiskCategoryService = (*RiskCategoryService)(nil)
+
+// RiskCategoryService implements service.RiskCategoryService interface.
+type RiskCategoryService struct {
+	diseases        service.DiseasesService
+	basicIndicators storage.BasicIndicatorsRepository
+
+	analyses      service.AnalysisService
+	score         service.ScoreService
+	bloodPressure service.BloodPressureService
+
+	authClient client.Auth
+}
+
+func NewRiskCategoryService(
+	diseases service.DiseasesService,
+	basicIndicators storage.BasicIndicatorsRepository,
+	analyses service.AnalysisService,
+	score service.ScoreService,
+	bloodPressure service.BloodPressureService,
+	authClient client.Auth,
+) *RiskCategoryService {
+	return &RiskCategoryService{
+		diseases:        diseases,
+		basicIndicators: basicIndicators,
+		analyses:        analyses,
+		score:           score,
+		bloodPressure:   bloodPressure,
+		authClient:      authClient,
+	}
+}
+
+func (s *RiskCategoryService) Get(userID uint64) (*domain.RiskCategory, error) {
+	diseases, err := s.diseases.Get(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	basicIndicators, err := s.basicIndicators.FindAll(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the derived values are calculated by the analysis service
+	analyses, err := s.analyses.FindAll(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	bloodPressureAverages, err := s.bloodPressure.WeeklyAverages(domain.BloodPressureDiaryCriteria{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.authClient.GetUser(context.TODO(), model.UserCriteria{
+		ID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	riskCategory, err := classifyRiskCategory(s.score, user, diseases, basicIndicators, analyses, bloodPressureAverages, true)
+	if err != nil {
+		return nil, err
+	}
+	if riskCategory == nil {
+		return nil, domain.ErrNotEnoughDataToClassifyRisk
+	}
+
+	return riskCategory, nil
+}
+
+// classifyRiskCategory classifies the user cardiovascular risk by the already loaded data: the basic indicators and
+// the analyses sorted from the newest to the oldest, the analyses derived values calculated. If the risk value is not
+// required, the SCORE risk is looked up only if the category is not determined by the diseases and the analyses alone.
+// If the data is insufficient, nil is returned.
+func classifyRiskCategory(
+	score service.ScoreService,
+	user model.User,
+	diseases *domain.Diseases,
+	basicIndicators []*domain.BasicIndicators,
+	analyses []*domain.Analysis,
+	bloodPressureAverages []*domain.BloodPressureWeeklyAverage,
+	riskValueRequired bool,
+) (*domain.RiskCategory, error) {
+	scoreData := domain.ExtractScoreDataFrom(basicIndicators, bloodPressureAverages)
+	scoreData.HDLCholesterolLevel = domain.ExtractHDLCholesterolLevelFrom(analyses)
+	scoreData.Age = user.Age()
+	scoreData.RiskScope = score.ResolveRiskScope(user.Region)
+
+	if !riskValueRequired {
+		// the very high category determined without SCORE can't be changed by the SCORE risk
+		riskCategory := domain.ClassifyRiskCategory(domain.NewRiskCategoryData(scoreData, nil, diseases, analyses))
+		if riskCategory != nil && riskCategory.Category == common.RiskCategoryVeryHigh {
+			return riskCategory, nil
+		}
+	}
+
+	var riskValue *float64
+	if err := scoreData.ValidateByRecommendation(domain.Risk, score.Ranges()); err == nil {
+		value, _, err := score.GetCVERisk(scoreData)
+		if err != nil {
+			return nil, err
+		}
+		riskValue = &value
+	}
+
+	return domain.ClassifyRiskCategory(domain.NewRiskCategoryData(scoreData, riskValue, diseases, analyses)), nil
+}
@@ -2,7 +2,10 @@ package service
 
 import (
 	"fmt"
+	"math"
+	"strings"
 
+	"github.com/cardio-analyst/backend/internal/gateway/config"
 	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
 	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
 	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
@@ -14,35 +17,54 @@ var _ service.ScoreService = (*ScoreService)(nil)
 
 // ScoreService implements service.ScoreService interface.
 type ScoreService struct {
-	score storage.ScoreRepository
+	cfg config.ScoreConfig
+
+	score     storage.ScoreRepository
+	riskModel service.RiskModel
 }
 
-func NewScoreService(score storage.ScoreRepository) *ScoreService {
+func NewScoreService(cfg config.ScoreConfig, score storage.ScoreRepository) *ScoreService {
+	var riskModel service.RiskModel
+	switch cfg.RiskModel {
+	case common.RiskModelSCORE2:
+		riskModel = NewSCORE2RiskModel()
+	default:
+		riskModel = NewChartsRiskModel(score)
+	}
+
 	return &ScoreService{
-		score: score,
+		cfg:       cfg,
+		score:     score,
+		riskModel: riskModel,
 	}
 }
 
-func (s *ScoreService) GetCVERisk(data domain.ScoreData) (uint64, string, error) {
+func (s *ScoreService) GetCVERisk(data domain.ScoreData) (float64, string, error) {
 	if err := data.Validate(domain.ValidationOptionsScore{
 		Age:                   true,
 		Gender:                true,
 		SBPLevel:              true,
 		TotalCholesterolLevel: true,
+		RiskScope:             true,
+		Ranges:                s.riskModel.Ranges(),
 	}); err != nil {
 		return 0, common.ScaleUnknown, err
 	}
 
-	riskValue, err := s.score.GetCVERisk(data)
+	riskValue, err := s.riskModel.CVERisk(data)
 	if err != nil {
 		return 0, common.ScaleUnknown, err
 	}
 
-	scale := s.ResolveScale(float64(riskValue), data.Age)
+	scale := s.ResolveScale(riskValue, data.Age)
 
 	return riskValue, scale, nil
 }
 
+func (s *ScoreService) Ranges() domain.ScoreDataRanges {
+	return s.riskModel.Ranges()
+}
+
 func (s *ScoreService) ResolveScale(riskValue float64, age int) string {
 	switch {
 	case age > 0 && age < 50:
@@ -83,17 +105,204 @@ func (s *ScoreService) ResolveScale(riskValue float64, age int) string {
 	}
 }
 
-func (s *ScoreService) GetIdealAge(data domain.ScoreData) (string, string, error) {
+// ideal profile of the modifiable risk factors used to calculate the cardiovascular age
+const (
+	idealSBPLevel              = 120.0
+	idealTotalCholesterolLevel = 4.0
+	idealHDLCholesterolLevel   = 1.3
+)
+
+func (s *ScoreService) GetCardiovascularAge(data domain.ScoreData) (*domain.CardiovascularAge, error) {
 	// pass SCORE data validation because GetCVERisk meth has it
 	riskValue, scale, err := s.GetCVERisk(data)
 	if err != nil {
-		return "", common.ScaleUnknown, err
+		return nil, err
+	}
+
+	age, err := s.idealProfileAge(data, riskValue)
+	if err != nil {
+		return nil, err
+	}
+
+	factorsData := []struct {
+		factor   string
+		modified bool
+		apply    func(data *domain.ScoreData)
+	}{
+		{
+			factor:   common.RiskFactorSmoking,
+			modified: data.Smoking,
+			apply: func(data *domain.ScoreData) {
+				data.Smoking = false
+			},
+		},
+		{
+			factor:   common.RiskFactorSBPLevel,
+			modified: data.SBPLevel > idealSBPLevel,
+			apply: func(data *domain.ScoreData) {
+				data.SBPLevel = idealSBPLevel
+			},
+		},
+		{
+			factor:   common.RiskFactorCholesterol,
+			modified: data.TotalCholesterolLevel > idealTotalCholesterolLevel,
+			apply: func(data *domain.ScoreData) {
+				data.TotalCholesterolLevel = idealTotalCholesterolLevel
+				data.HDLCholesterolLevel = idealHDLCholesterolLevel
+			},
+		},
+	}
+
+	factors := make([]*domain.RiskFactorContribution, 0, len(factorsData))
+	for _, factorData := range factorsData {
+		factor := &domain.RiskFactorContribution{
+			Factor:    factorData.factor,
+			RiskValue: riskValue,
+		}
+
+		if factorData.modified {
+			modifiedData := data
+			factorData.apply(&modifiedData)
+
+			factor.RiskValue, err = s.riskModel.CVERisk(modifiedData)
+			if err != nil {
+				return nil, err
+			}
+
+			var modifiedAge int
+			modifiedAge, err = s.idealProfileAge(data, factor.RiskValue)
+			if err != nil {
+				return nil, err
+			}
+
+			factor.Years = age - modifiedAge
+		}
+
+		factors = append(factors, factor)
+	}
+
+	return &domain.CardiovascularAge{
+		Age:       age,
+		RiskValue: riskValue,
+		Scale:     scale,
+		Factors:   factors,
+	}, nil
+}
+
+func (s *ScoreService) Simulate(data domain.ScoreData, scenarios []*domain.ScoreScenario) (*domain.ScoreSimulation, error) {
+	if len(scenarios) == 0 || len(scenarios) > domain.MaxScoreScenarios {
+		return nil, fmt.Errorf("%w: from 1 to %v scenarios expected", domain.ErrInvalidScoreScenarios, domain.MaxScoreScenarios)
+	}
+
+	baseline, err := s.simulationResult(data, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*domain.ScoreSimulationResult, 0, len(scenarios))
+	for i, scenario := range scenarios {
+		if scenario == nil {
+			return nil, fmt.Errorf("%w: scenario %v is empty", domain.ErrInvalidScoreScenarios, i)
+		}
+
+		var result *domain.ScoreSimulationResult
+		result, err = s.simulationResult(scenario.ApplyTo(data), baseline)
+		if err != nil {
+			return nil, fmt.Errorf("scenario %v: %w", i, err)
+		}
+		result.Name = scenario.Name
+
+		results = append(results, result)
+	}
+
+	return &domain.ScoreSimulation{
+		Baseline:  baseline,
+		Scenarios: results,
+	}, nil
+}
+
+// simulationResult calculates the risk and the cardiovascular age of the data, the risk factors contributions are not
+// needed for the simulation. The risk difference is calculated relative to the baseline unless the result is the
+// baseline itself (nil baseline).
+func (s *ScoreService) simulationResult(data domain.ScoreData, baseline *domain.ScoreSimulationResult) (*domain.ScoreSimulationResult, error) {
+	riskValue, scale, err := s.GetCVERisk(data)
+	if err != nil {
+		return nil, err
 	}
 
-	min, max, err := s.score.GetIdealAge(riskValue, data)
+	age, err := s.idealProfileAge(data, riskValue)
 	if err != nil {
-		return "", common.ScaleUnknown, err
+		return nil, err
+	}
+
+	var riskDifference float64
+	if baseline != nil {
+		riskDifference = math.Round((riskValue-baseline.RiskValue)*10) / 10
+	}
+
+	_, approximate := data.NonHDLCholesterolLevel()
+
+	return &domain.ScoreSimulationResult{
+		RiskValue:         riskValue,
+		Scale:             scale,
+		CardiovascularAge: age,
+		RiskDifference:    riskDifference,
+		Approximate:       approximate,
+	}, nil
+}
+
+// idealProfileAge solves for the age at which a person of the same gender with the ideal profile of the modifiable
+// risk factors has the given risk value. The result is limited by the age range supported by the risk model.
+func (s *ScoreService) idealProfileAge(data domain.ScoreData, riskValue float64) (int, error) {
+	idealData := domain.ScoreData{
+		Gender:                data.Gender,
+		Smoking:               false,
+		SBPLevel:              idealSBPLevel,
+		TotalCholesterolLevel: idealTotalCholesterolLevel,
+		HDLCholesterolLevel:   idealHDLCholesterolLevel,
+		RiskScope:             data.RiskScope,
+	}
+
+	ranges := s.riskModel.Ranges()
+
+	// the risk grows with age, so the binary search finds the youngest age with the same or higher risk; note that
+	// the risk increases stepwise at the age the SCORE2-OP charts (equations) start from, so all the risk values
+	// in this step result in this age
+	ageMin, ageMax := ranges.AgeMin, ranges.AgeMax
+	for ageMin < ageMax {
+		idealData.Age = ageMin + (ageMax-ageMin)/2
+
+		idealRiskValue, err := s.riskModel.CVERisk(idealData)
+		if err != nil {
+			return 0, err
+		}
+
+		if idealRiskValue >= riskValue {
+			ageMax = idealData.Age
+		} else {
+			ageMin = idealData.Age + 1
+		}
+	}
+
+	return ageMin, nil
+}
+
+// ResolveRiskScope returns the risk scope configured for the user region (or country). If the region is not
+// configured, the default risk scope is returned.
+func (s *ScoreService) ResolveRiskScope(region string) string {
+	region = strings.TrimSpace(region)
+	if region != "" {
+		for configuredRegion, riskScope := range s.cfg.RegionRiskScopes {
+			if strings.EqualFold(configuredRegion, region) {
+				return riskScope
+			}
+		}
+	}
+
+	if s.cfg.DefaultRiskScope != "" {
+		return s.cfg.DefaultRiskScope
 	}
 
-	return fmt.Sprintf("%v-%v", min, max), scale, nil
+	// historically the service used Russian (very high risk) charts only
+	return common.RiskScopeVeryHigh
 }
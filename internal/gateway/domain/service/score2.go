@@ -0,0 +1,235 @@
+package service
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
+)
+
+// score2OPMinAge is the age starting from which SCORE2-OP (older persons) equations are used instead of SCORE2 ones.
+const score2OPMinAge = 70
+
+// score2DefaultHDLCholesterolLevel is the HDL cholesterol level (mmol/L) assumed by the SCORE2 charts, it is used when
+// the real value is unknown.
+const score2DefaultHDLCholesterolLevel = 1.3
+
+// score2ScoreDataRanges describes the ranges the SCORE2 and SCORE2-OP equations are applied to.
+var score2ScoreDataRanges = domain.ScoreDataRanges{
+	AgeMin:                   40,
+	AgeMax:                   100,
+	SBPLevelMin:              80.0,
+	SBPLevelMax:              250.0,
+	TotalCholesterolLevelMin: 3.0,
+	TotalCholesterolLevelMax: 15.2,
+}
+
+// score2Coefficients represents the sex-specific subdistribution hazard ratios (log) of the SCORE2 or SCORE2-OP model.
+type score2Coefficients struct {
+	age                 float64
+	smoking             float64
+	sbp                 float64
+	totalCholesterol    float64
+	hdlCholesterol      float64
+	smokingAge          float64
+	sbpAge              float64
+	totalCholesterolAge float64
+	hdlCholesterolAge   float64
+	baselineSurvival    float64
+	meanLinearPredictor float64
+}
+
+// score2Calibration represents the region and sex-specific recalibration scales.
+type score2Calibration struct {
+	scale1 float64
+	scale2 float64
+}
+
+// SCORE2 (age 40-69), see SCORE2 working group and ESC Cardiovascular risk collaboration, Eur Heart J. 2021;42:2439–54.
+var score2Coefficients2021 = map[string]score2Coefficients{
+	common.UserGenderMale: {
+		age:                 0.3742,
+		smoking:             0.6012,
+		sbp:                 0.2777,
+		totalCholesterol:    0.1458,
+		hdlCholesterol:      -0.2698,
+		smokingAge:          -0.0755,
+		sbpAge:              -0.0255,
+		totalCholesterolAge: -0.0281,
+		hdlCholesterolAge:   0.0426,
+		baselineSurvival:    0.9605,
+	},
+	common.UserGenderFemale: {
+		age:                 0.4648,
+		smoking:             0.7744,
+		sbp:                 0.3131,
+		totalCholesterol:    0.1002,
+		hdlCholesterol:      -0.2606,
+		smokingAge:          -0.1088,
+		sbpAge:              -0.0277,
+		totalCholesterolAge: -0.0226,
+		hdlCholesterolAge:   0.0613,
+		baselineSurvival:    0.9776,
+	},
+}
+
+var score2Calibrations2021 = map[string]map[string]score2Calibration{
+	common.RiskScopeLow: {
+		common.UserGenderMale:   {scale1: -0.5699, scale2: 0.7476},
+		common.UserGenderFemale: {scale1: -0.7380, scale2: 0.7019},
+	},
+	common.RiskScopeModerate: {
+		common.UserGenderMale:   {scale1: -0.1565, scale2: 0.8009},
+		common.UserGenderFemale: {scale1: -0.3143, scale2: 0.7701},
+	},
+	common.RiskScopeHigh: {
+		common.UserGenderMale:   {scale1: 0.3207, scale2: 0.9360},
+		common.UserGenderFemale: {scale1: 0.5710, scale2: 0.9369},
+	},
+	common.RiskScopeVeryHigh: {
+		common.UserGenderMale:   {scale1: 0.5836, scale2: 0.8294},
+		common.UserGenderFemale: {scale1: 0.9412, scale2: 0.8329},
+	},
+}
+
+// SCORE2-OP (age 70+), see SCORE2-OP working group and ESC Cardiovascular risk collaboration, Eur Heart J. 2021;42:2455–67.
+var score2OPCoefficients2021 = map[string]score2Coefficients{
+	common.UserGenderMale: {
+		age:                 0.0634,
+		smoking:             0.3524,
+		sbp:                 0.0094,
+		totalCholesterol:    0.0850,
+		hdlCholesterol:      -0.3564,
+		smokingAge:          -0.0247,
+		sbpAge:              -0.0005,
+		totalCholesterolAge: 0.0073,
+		hdlCholesterolAge:   0.0091,
+		baselineSurvival:    0.7576,
+		meanLinearPredictor: 0.0929,
+	},
+	common.UserGenderFemale: {
+		age:                 0.0789,
+		smoking:             0.4921,
+		sbp:                 0.0102,
+		totalCholesterol:    0.0605,
+		hdlCholesterol:      -0.3040,
+		smokingAge:          -0.0255,
+		sbpAge:              -0.0004,
+		totalCholesterolAge: -0.0009,
+		hdlCholesterolAge:   0.0154,
+		baselineSurvival:    0.8082,
+		meanLinearPredictor: 0.2290,
+	},
+}
+
+var score2OPCalibrations2021 = map[string]map[string]score2Calibration{
+	common.RiskScopeLow: {
+		common.UserGenderMale:   {scale1: -0.34, scale2: 1.19},
+		common.UserGenderFemale: {scale1: -0.52, scale2: 1.01},
+	},
+	common.RiskScopeModerate: {
+		common.UserGenderMale:   {scale1: 0.01, scale2: 1.25},
+		common.UserGenderFemale: {scale1: -0.10, scale2: 1.10},
+	},
+	common.RiskScopeHigh: {
+		common.UserGenderMale:   {scale1: 0.08, scale2: 1.15},
+		common.UserGenderFemale: {scale1: 0.38, scale2: 1.09},
+	},
+	common.RiskScopeVeryHigh: {
+		common.UserGenderMale:   {scale1: 0.05, scale2: 0.70},
+		common.UserGenderFemale: {scale1: 0.38, scale2: 0.69},
+	},
+}
+
+// check whether SCORE2RiskModel structure implements the service.RiskModel interface
+var _ service.RiskModel = (*SCORE2RiskModel)(nil)
+
+// SCORE2RiskModel implements service.RiskModel interface using the SCORE2 equations for people aged 40-69 and the
+// SCORE2-OP equations for people aged 70 and older. Both are recalibrated to the risk scope region.
+type SCORE2RiskModel struct{}
+
+func NewSCORE2RiskModel() *SCORE2RiskModel {
+	return &SCORE2RiskModel{}
+}
+
+func (m *SCORE2RiskModel) CVERisk(data domain.ScoreData) (float64, error) {
+	hdlCholesterolLevel := score2DefaultHDLCholesterolLevel
+	if _, approximate := data.NonHDLCholesterolLevel(); !approximate {
+		hdlCholesterolLevel = data.HDLCholesterolLevel
+	}
+
+	risk, err := score2Risk(
+		float64(data.Age), data.Gender, data.Smoking, data.SBPLevel, data.TotalCholesterolLevel, hdlCholesterolLevel,
+		data.RiskScope,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	// percents rounded to one decimal place
+	return math.Round(risk*1000) / 10, nil
+}
+
+// score2Risk returns the 10-year calibrated risk (fraction) of the cardiovascular events calculated by the SCORE2
+// equations or by the SCORE2-OP ones starting from score2OPMinAge. The age is fractional, so the risk charts cells
+// calculated at the age categories midpoints can be reproduced.
+func score2Risk(
+	age float64, gender string, smoking bool, sbpLevel, totalCholesterolLevel, hdlCholesterolLevel float64,
+	riskScope string,
+) (float64, error) {
+	var smoker float64
+	if smoking {
+		smoker = 1
+	}
+
+	var (
+		coefficients             map[string]score2Coefficients
+		calibrations             map[string]map[string]score2Calibration
+		ageTerm, sbpTerm         float64
+		cholesterolTerm, hdlTerm float64
+	)
+	if age < score2OPMinAge {
+		coefficients, calibrations = score2Coefficients2021, score2Calibrations2021
+
+		ageTerm = (age - 60) / 5
+		sbpTerm = (sbpLevel - 120) / 20
+		cholesterolTerm = totalCholesterolLevel - 6
+		hdlTerm = (hdlCholesterolLevel - 1.3) / 0.5
+	} else {
+		coefficients, calibrations = score2OPCoefficients2021, score2OPCalibrations2021
+
+		ageTerm = age - 73
+		sbpTerm = sbpLevel - 150
+		cholesterolTerm = totalCholesterolLevel - 6
+		hdlTerm = hdlCholesterolLevel - 1.4
+	}
+
+	c, found := coefficients[gender]
+	if !found {
+		return 0, fmt.Errorf("unknown user gender: %v", gender)
+	}
+
+	calibration, found := calibrations[riskScope][gender]
+	if !found {
+		return 0, fmt.Errorf("unknown risk scope: %v", riskScope)
+	}
+
+	linearPredictor := c.age*ageTerm + c.smoking*smoker + c.sbp*sbpTerm + c.totalCholesterol*cholesterolTerm +
+		c.hdlCholesterol*hdlTerm + c.smokingAge*smoker*ageTerm + c.sbpAge*sbpTerm*ageTerm +
+		c.totalCholesterolAge*cholesterolTerm*ageTerm + c.hdlCholesterolAge*hdlTerm*ageTerm - c.meanLinearPredictor
+
+	uncalibratedRisk := 1 - math.Pow(c.baselineSurvival, math.Exp(linearPredictor))
+
+	return calibration.apply(uncalibratedRisk), nil
+}
+
+// apply recalibrates the risk (fraction) estimated by the equations to the risk region.
+func (c score2Calibration) apply(risk float64) float64 {
+	return 1 - math.Exp(-math.Exp(c.scale1+c.scale2*math.Log(-math.Log(1-risk))))
+}
+
+func (m *SCORE2RiskModel) Ranges() domain.ScoreDataRanges {
+	return score2ScoreDataRanges
+}
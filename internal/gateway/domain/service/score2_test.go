@@ -0,0 +1,226 @@
+package service
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+)
+
+// score2ChartCell is the cell of the ESC 2021 SCORE2 and SCORE2-OP risk charts (see the risk charts tables seeded by
+// the migrations). The cell is identified by the lower bounds of its age, SBP and non-HDL cholesterol categories.
+type score2ChartCell struct {
+	riskScope         string
+	gender            string
+	smoking           bool
+	ageMin            int
+	sbpLevelMin       float64
+	nonHDLCholesterol float64
+	riskValue         float64
+}
+
+// score2ChartCells are the corner and the middle cells of every chart, the age categories from 70 years are the
+// SCORE2-OP ones.
+var score2ChartCells = []score2ChartCell{
+	{common.RiskScopeLow, common.UserGenderMale, false, 40, 100, 3.0, 1},
+	{common.RiskScopeLow, common.UserGenderMale, false, 55, 140, 5.0, 7},
+	{common.RiskScopeLow, common.UserGenderMale, false, 65, 160, 6.0, 13},
+	{common.RiskScopeLow, common.UserGenderMale, false, 70, 120, 4.0, 10},
+	{common.RiskScopeLow, common.UserGenderMale, false, 85, 160, 6.0, 49},
+	{common.RiskScopeLow, common.UserGenderMale, true, 40, 100, 3.0, 3},
+	{common.RiskScopeLow, common.UserGenderMale, true, 55, 140, 5.0, 10},
+	{common.RiskScopeLow, common.UserGenderMale, true, 65, 160, 6.0, 18},
+	{common.RiskScopeLow, common.UserGenderMale, true, 70, 120, 4.0, 15},
+	{common.RiskScopeLow, common.UserGenderMale, true, 85, 160, 6.0, 49},
+	{common.RiskScopeLow, common.UserGenderFemale, false, 40, 100, 3.0, 1},
+	{common.RiskScopeLow, common.UserGenderFemale, false, 55, 140, 5.0, 4},
+	{common.RiskScopeLow, common.UserGenderFemale, false, 65, 160, 6.0, 9},
+	{common.RiskScopeLow, common.UserGenderFemale, false, 70, 120, 4.0, 8},
+	{common.RiskScopeLow, common.UserGenderFemale, false, 85, 160, 6.0, 31},
+	{common.RiskScopeLow, common.UserGenderFemale, true, 40, 100, 3.0, 2},
+	{common.RiskScopeLow, common.UserGenderFemale, true, 55, 140, 5.0, 7},
+	{common.RiskScopeLow, common.UserGenderFemale, true, 65, 160, 6.0, 14},
+	{common.RiskScopeLow, common.UserGenderFemale, true, 70, 120, 4.0, 12},
+	{common.RiskScopeLow, common.UserGenderFemale, true, 85, 160, 6.0, 35},
+	{common.RiskScopeModerate, common.UserGenderMale, false, 40, 100, 3.0, 2},
+	{common.RiskScopeModerate, common.UserGenderMale, false, 55, 140, 5.0, 8},
+	{common.RiskScopeModerate, common.UserGenderMale, false, 65, 160, 6.0, 17},
+	{common.RiskScopeModerate, common.UserGenderMale, false, 70, 120, 4.0, 13},
+	{common.RiskScopeModerate, common.UserGenderMale, false, 85, 160, 6.0, 61},
+	{common.RiskScopeModerate, common.UserGenderMale, true, 40, 100, 3.0, 3},
+	{common.RiskScopeModerate, common.UserGenderMale, true, 55, 140, 5.0, 14},
+	{common.RiskScopeModerate, common.UserGenderMale, true, 65, 160, 6.0, 25},
+	{common.RiskScopeModerate, common.UserGenderMale, true, 70, 120, 4.0, 20},
+	{common.RiskScopeModerate, common.UserGenderMale, true, 85, 160, 6.0, 61},
+	{common.RiskScopeModerate, common.UserGenderFemale, false, 40, 100, 3.0, 1},
+	{common.RiskScopeModerate, common.UserGenderFemale, false, 55, 140, 5.0, 5},
+	{common.RiskScopeModerate, common.UserGenderFemale, false, 65, 160, 6.0, 12},
+	{common.RiskScopeModerate, common.UserGenderFemale, false, 70, 120, 4.0, 9},
+	{common.RiskScopeModerate, common.UserGenderFemale, false, 85, 160, 6.0, 42},
+	{common.RiskScopeModerate, common.UserGenderFemale, true, 40, 100, 3.0, 2},
+	{common.RiskScopeModerate, common.UserGenderFemale, true, 55, 140, 5.0, 9},
+	{common.RiskScopeModerate, common.UserGenderFemale, true, 65, 160, 6.0, 18},
+	{common.RiskScopeModerate, common.UserGenderFemale, true, 70, 120, 4.0, 16},
+	{common.RiskScopeModerate, common.UserGenderFemale, true, 85, 160, 6.0, 47},
+	{common.RiskScopeHigh, common.UserGenderMale, false, 40, 100, 3.0, 1},
+	{common.RiskScopeHigh, common.UserGenderMale, false, 55, 140, 5.0, 10},
+	{common.RiskScopeHigh, common.UserGenderMale, false, 65, 160, 6.0, 22},
+	{common.RiskScopeHigh, common.UserGenderMale, false, 70, 120, 4.0, 17},
+	{common.RiskScopeHigh, common.UserGenderMale, false, 85, 160, 6.0, 65},
+	{common.RiskScopeHigh, common.UserGenderMale, true, 40, 100, 3.0, 3},
+	{common.RiskScopeHigh, common.UserGenderMale, true, 55, 140, 5.0, 17},
+	{common.RiskScopeHigh, common.UserGenderMale, true, 65, 160, 6.0, 32},
+	{common.RiskScopeHigh, common.UserGenderMale, true, 70, 120, 4.0, 24},
+	{common.RiskScopeHigh, common.UserGenderMale, true, 85, 160, 6.0, 65},
+	{common.RiskScopeHigh, common.UserGenderFemale, false, 40, 100, 3.0, 1},
+	{common.RiskScopeHigh, common.UserGenderFemale, false, 55, 140, 5.0, 7},
+	{common.RiskScopeHigh, common.UserGenderFemale, false, 65, 160, 6.0, 18},
+	{common.RiskScopeHigh, common.UserGenderFemale, false, 70, 120, 4.0, 15},
+	{common.RiskScopeHigh, common.UserGenderFemale, false, 85, 160, 6.0, 58},
+	{common.RiskScopeHigh, common.UserGenderFemale, true, 40, 100, 3.0, 2},
+	{common.RiskScopeHigh, common.UserGenderFemale, true, 55, 140, 5.0, 14},
+	{common.RiskScopeHigh, common.UserGenderFemale, true, 65, 160, 6.0, 30},
+	{common.RiskScopeHigh, common.UserGenderFemale, true, 70, 120, 4.0, 24},
+	{common.RiskScopeHigh, common.UserGenderFemale, true, 85, 160, 6.0, 63},
+	{common.RiskScopeVeryHigh, common.UserGenderMale, false, 40, 100, 3.0, 3},
+	{common.RiskScopeVeryHigh, common.UserGenderMale, false, 55, 140, 5.0, 16},
+	{common.RiskScopeVeryHigh, common.UserGenderMale, false, 65, 160, 6.0, 32},
+	{common.RiskScopeVeryHigh, common.UserGenderMale, false, 70, 120, 4.0, 30},
+	{common.RiskScopeVeryHigh, common.UserGenderMale, false, 85, 160, 6.0, 64},
+	{common.RiskScopeVeryHigh, common.UserGenderMale, true, 40, 100, 3.0, 6},
+	{common.RiskScopeVeryHigh, common.UserGenderMale, true, 55, 140, 5.0, 26},
+	{common.RiskScopeVeryHigh, common.UserGenderMale, true, 65, 160, 6.0, 44},
+	{common.RiskScopeVeryHigh, common.UserGenderMale, true, 70, 120, 4.0, 36},
+	{common.RiskScopeVeryHigh, common.UserGenderMale, true, 85, 160, 6.0, 64},
+	{common.RiskScopeVeryHigh, common.UserGenderFemale, false, 40, 100, 3.0, 2},
+	{common.RiskScopeVeryHigh, common.UserGenderFemale, false, 55, 140, 5.0, 13},
+	{common.RiskScopeVeryHigh, common.UserGenderFemale, false, 65, 160, 6.0, 31},
+	{common.RiskScopeVeryHigh, common.UserGenderFemale, false, 70, 120, 4.0, 30},
+	{common.RiskScopeVeryHigh, common.UserGenderFemale, false, 85, 160, 6.0, 65},
+	{common.RiskScopeVeryHigh, common.UserGenderFemale, true, 40, 100, 3.0, 5},
+	{common.RiskScopeVeryHigh, common.UserGenderFemale, true, 55, 140, 5.0, 24},
+	{common.RiskScopeVeryHigh, common.UserGenderFemale, true, 65, 160, 6.0, 46},
+	{common.RiskScopeVeryHigh, common.UserGenderFemale, true, 70, 120, 4.0, 40},
+	{common.RiskScopeVeryHigh, common.UserGenderFemale, true, 85, 160, 6.0, 68},
+}
+
+// TestSCORE2RiskChartCells checks the equations against the risk charts. The charts values are calculated at the
+// categories midpoints with the HDL cholesterol of 1.3 mmol/L and are rounded to the whole percents, so the rounded
+// risk may deviate from the chart value by one percent.
+func TestSCORE2RiskChartCells(t *testing.T) {
+	for _, cell := range score2ChartCells {
+		age := float64(cell.ageMin) + 2.5
+		sbpLevel := cell.sbpLevelMin + 10
+		totalCholesterolLevel := cell.nonHDLCholesterol + 0.5 + score2DefaultHDLCholesterolLevel
+
+		risk, err := score2Risk(
+			age, cell.gender, cell.smoking, sbpLevel, totalCholesterolLevel, score2DefaultHDLCholesterolLevel,
+			cell.riskScope,
+		)
+		if err != nil {
+			t.Fatalf("%+v: unexpected error: %v", cell, err)
+		}
+
+		if got := risk * 100; math.Abs(math.Round(got)-cell.riskValue) > 1 {
+			t.Errorf("%+v: got risk %.2f%%, want %v%%", cell, got, cell.riskValue)
+		}
+	}
+}
+
+func TestSCORE2Calibration(t *testing.T) {
+	// the calibration with the zero intercept and the unit slope keeps the risk unchanged
+	identity := score2Calibration{scale1: 0, scale2: 1}
+	for _, risk := range []float64{0.01, 0.1, 0.5} {
+		if got := identity.apply(risk); math.Abs(got-risk) > 1e-12 {
+			t.Errorf("identity calibration of %v: got %v", risk, got)
+		}
+	}
+
+	// the same profile is at the higher risk in the higher risk region, the regions differ only by the calibration
+	scopes := []string{common.RiskScopeLow, common.RiskScopeModerate, common.RiskScopeHigh, common.RiskScopeVeryHigh}
+	for _, age := range []float64{50, 75} {
+		for _, gender := range []string{common.UserGenderMale, common.UserGenderFemale} {
+			previous := 0.0
+			for _, scope := range scopes {
+				risk, err := score2Risk(age, gender, true, 140, 6.3, 1.3, scope)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if risk <= previous {
+					t.Errorf("age %v, %v, %v region: risk %v is not greater than %v", age, gender, scope, risk, previous)
+				}
+				previous = risk
+			}
+		}
+	}
+}
+
+func TestSCORE2RiskModelCVERisk(t *testing.T) {
+	model := NewSCORE2RiskModel()
+
+	testCases := []struct {
+		name    string
+		data    domain.ScoreData
+		want    float64
+		wantErr bool
+	}{
+		{
+			name: "SCORE2",
+			data: domain.ScoreData{
+				Age: 55, Gender: common.UserGenderMale, Smoking: true, SBPLevel: 150, TotalCholesterolLevel: 6.8,
+				HDLCholesterolLevel: 1.1, RiskScope: common.RiskScopeModerate,
+			},
+			want: 13.8,
+		},
+		{
+			name: "SCORE2 with the HDL cholesterol assumed",
+			data: domain.ScoreData{
+				Age: 55, Gender: common.UserGenderMale, Smoking: true, SBPLevel: 150, TotalCholesterolLevel: 6.8,
+				RiskScope: common.RiskScopeModerate,
+			},
+			want: 12.5,
+		},
+		{
+			name: "SCORE2-OP",
+			data: domain.ScoreData{
+				Age: 70, Gender: common.UserGenderFemale, Smoking: false, SBPLevel: 130, TotalCholesterolLevel: 5.8,
+				HDLCholesterolLevel: 1.3, RiskScope: common.RiskScopeHigh,
+			},
+			want: 12.2,
+		},
+		{
+			name: "unknown gender",
+			data: domain.ScoreData{
+				Age: 55, Gender: common.UserGenderUnknown, SBPLevel: 150, TotalCholesterolLevel: 6.8,
+				RiskScope: common.RiskScopeModerate,
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown risk scope",
+			data: domain.ScoreData{
+				Age: 55, Gender: common.UserGenderMale, SBPLevel: 150, TotalCholesterolLevel: 6.8, RiskScope: "unknown",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := model.CVERisk(testCase.data)
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got risk %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != testCase.want {
+				t.Errorf("got risk %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"math"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+// chartsScoreDataRanges describes the ranges covered by the risk charts tables.
+var chartsScoreDataRanges = domain.ScoreDataRanges{
+	AgeMin:                   40,
+	AgeMax:                   89,
+	SBPLevelMin:              100.0,
+	SBPLevelMax:              179.0,
+	TotalCholesterolLevelMin: 3.0,
+	TotalCholesterolLevelMax: 6.9,
+}
+
+// check whether ChartsRiskModel structure implements the service.RiskModel interface
+var _ service.RiskModel = (*ChartsRiskModel)(nil)
+
+// ChartsRiskModel implements service.RiskModel interface using the SCORE2 and SCORE2-OP charts stored in the database.
+type ChartsRiskModel struct {
+	score storage.ScoreRepository
+}
+
+func NewChartsRiskModel(score storage.ScoreRepository) *ChartsRiskModel {
+	return &ChartsRiskModel{
+		score: score,
+	}
+}
+
+func (m *ChartsRiskModel) CVERisk(data domain.ScoreData) (float64, error) {
+	// the charts rows cover the whole mmHg, while the home blood pressure average is fractional
+	data.SBPLevel = math.Round(data.SBPLevel)
+
+	riskValue, err := m.score.GetCVERisk(data)
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(riskValue), nil
+}
+
+func (m *ChartsRiskModel) Ranges() domain.ScoreDataRanges {
+	return chartsScoreDataRanges
+}
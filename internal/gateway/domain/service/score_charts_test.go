@@ -0,0 +1,66 @@
+package service
+
+import (
+	"database/sql"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/pkg/model"
+)
+
+// chartsTestRepository emulates the risk charts tables lookup: the SBP bands are integer, the non-HDL cholesterol
+// bands have one decimal place, so the values between the bands are not found.
+type chartsTestRepository struct {
+	data *domain.ScoreData
+}
+
+func (r *chartsTestRepository) GetCVERisk(data domain.ScoreData) (uint64, error) {
+	nonHDLCholesterolLevel, _ := data.NonHDLCholesterolLevel()
+	if data.SBPLevel != math.Trunc(data.SBPLevel) ||
+		nonHDLCholesterolLevel*10 != math.Trunc(nonHDLCholesterolLevel*10) {
+		return 0, sql.ErrNoRows
+	}
+
+	r.data = &data
+	return 7, nil
+}
+
+func TestChartsRiskModelHomeBloodPressureAverage(t *testing.T) {
+	gender, smoking, totalCholesterolLevel := common.UserGenderMale, false, 5.0
+	basicIndicators := []*domain.BasicIndicators{
+		{Gender: &gender, Smoking: &smoking, TotalCholesterolLevel: &totalCholesterolLevel},
+	}
+
+	// the first day is discarded, the rest average to 139.5
+	seriesStart := time.Date(2026, time.October, 5, 8, 0, 0, 0, time.UTC)
+	measurements := make([]*domain.BloodPressureMeasurement, 0, 5)
+	for day, systolic := range []int{150, 139, 140, 139, 140} {
+		measurements = append(measurements, &domain.BloodPressureMeasurement{
+			Systolic:   systolic,
+			Diastolic:  85,
+			MeasuredAt: model.Datetime{Time: seriesStart.AddDate(0, 0, day)},
+		})
+	}
+
+	data := domain.ExtractScoreDataFrom(basicIndicators, domain.BloodPressureWeeklyAverages(measurements))
+	if data.SBPLevel != 139.5 {
+		t.Fatalf("got SBP level %v, want the home blood pressure average 139.5", data.SBPLevel)
+	}
+	data.Age = 55
+	data.RiskScope = common.RiskScopeModerate
+
+	repository := &chartsTestRepository{}
+	riskValue, err := NewChartsRiskModel(repository).CVERisk(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if riskValue != 7 {
+		t.Errorf("got risk %v, want 7", riskValue)
+	}
+	if repository.data.SBPLevel != 140 {
+		t.Errorf("got charts SBP level %v, want 140", repository.data.SBPLevel)
+	}
+}
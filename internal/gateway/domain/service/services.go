@@ -22,19 +22,32 @@ type Services struct {
 	authClient      client.Auth
 	analyticsClient client.Analytics
 
-	userService            service.UserService
-	authService            service.AuthService
-	diseasesService        service.DiseasesService
-	analysisService        service.AnalysisService
-	lifestyleService       service.LifestyleService
-	questionnaireService   service.QuestionnaireService
-	basicIndicatorsService service.BasicIndicatorsService
-	scoreService           service.ScoreService
-	recommendationsService service.RecommendationsService
-	emailService           service.EmailService
-	feedbackService        service.FeedbackService
-	statisticsService      service.StatisticsService
-	reportService          service.ReportService
+	userService              service.UserService
+	authService              service.AuthService
+	diseasesService          service.DiseasesService
+	analysisService          service.AnalysisService
+	lifestyleService         service.LifestyleService
+	questionnaireService     service.QuestionnaireService
+	basicIndicatorsService   service.BasicIndicatorsService
+	scoreService             service.ScoreService
+	recommendationsService   service.RecommendationsService
+	emailService             service.EmailService
+	feedbackService          service.FeedbackService
+	statisticsService        service.StatisticsService
+	reportService            service.ReportService
+	preferencesService       service.PreferencesService
+	labTestsService          service.LabTestsService
+	labResultsService        service.LabResultsService
+	trendsService            service.TrendsService
+	bloodPressureService     service.BloodPressureService
+	hypertensionService      service.HypertensionService
+	medicationsService       service.MedicationsService
+	testsService             service.TestsService
+	riskCategoryService      service.RiskCategoryService
+	lipidManagementService   service.LipidManagementService
+	metabolicSyndromeService service.MetabolicSyndromeService
+	glucoseService           service.GlucoseService
+	activityService          service.ActivityService
 }
 
 type ServicesOptions struct {
@@ -84,7 +97,7 @@ func (s *Services) Diseases() service.DiseasesService {
 		return s.diseasesService
 	}
 
-	s.diseasesService = NewDiseasesService(s.storage.Diseases())
+	s.diseasesService = NewDiseasesService(s.storage.Diseases(), s.storage.Medications())
 
 	return s.diseasesService
 }
@@ -94,7 +107,13 @@ func (s *Services) Analysis() service.AnalysisService {
 		return s.analysisService
 	}
 
-	s.analysisService = NewAnalysisService(s.storage.Analyses())
+	s.analysisService = NewAnalysisService(
+		s.cfg.ReferenceRanges,
+		s.storage.Analyses(),
+		s.storage.LabTests(),
+		s.storage.BasicIndicators(),
+		s.authClient,
+	)
 
 	return s.analysisService
 }
@@ -134,7 +153,7 @@ func (s *Services) Score() service.ScoreService {
 		return s.scoreService
 	}
 
-	s.scoreService = NewScoreService(s.storage.Score())
+	s.scoreService = NewScoreService(s.cfg.Score, s.storage.Score())
 
 	return s.scoreService
 }
@@ -146,10 +165,17 @@ func (s *Services) Recommendations() service.RecommendationsService {
 
 	s.recommendationsService = NewRecommendationsService(
 		s.cfg.Recommendations,
-		s.storage.Diseases(),
+		s.Diseases(),
 		s.storage.BasicIndicators(),
 		s.storage.Lifestyles(),
-		s.storage.Score(),
+		s.storage.Analyses(),
+		s.storage.Questionnaire(),
+		s.storage.Glucose(),
+		s.storage.LabResults(),
+		s.storage.Activity(),
+		s.Score(),
+		s.BloodPressure(),
+		s.MetabolicSyndrome(),
 		s.authClient,
 	)
 
@@ -187,6 +213,10 @@ func (s *Services) Statistics() service.StatisticsService {
 		s.authClient,
 		s.storage.Diseases(),
 		s.storage.BasicIndicators(),
+		s.storage.Analyses(),
+		s.storage.BloodPressure(),
+		s.storage.Activity(),
+		s.Score(),
 	)
 
 	return s.statisticsService
@@ -199,10 +229,167 @@ func (s *Services) Report() service.ReportService {
 
 	s.reportService = NewPDFReportService(
 		s.Recommendations(),
-		s.storage.Analyses(),
+		s.Analysis(),
+		s.LabTests(),
+		s.LabResults(),
+		s.BloodPressure(),
+		s.Hypertension(),
+		s.RiskCategory(),
+		s.MetabolicSyndrome(),
+		s.Glucose(),
 		s.storage.BasicIndicators(),
 		s.authClient,
 	)
 
 	return s.reportService
 }
+
+func (s *Services) Preferences() service.PreferencesService {
+	if s.preferencesService != nil {
+		return s.preferencesService
+	}
+
+	s.preferencesService = NewPreferencesService(s.storage.Preferences())
+
+	return s.preferencesService
+}
+
+func (s *Services) LabTests() service.LabTestsService {
+	if s.labTestsService != nil {
+		return s.labTestsService
+	}
+
+	s.labTestsService = NewLabTestsService(s.storage.LabTests())
+
+	return s.labTestsService
+}
+
+func (s *Services) LabResults() service.LabResultsService {
+	if s.labResultsService != nil {
+		return s.labResultsService
+	}
+
+	s.labResultsService = NewLabResultsService(s.storage.LabTests(), s.storage.LabResults())
+
+	return s.labResultsService
+}
+
+func (s *Services) Trends() service.TrendsService {
+	if s.trendsService != nil {
+		return s.trendsService
+	}
+
+	s.trendsService = NewTrendsService(s.storage.Trends(), s.storage.LabTests())
+
+	return s.trendsService
+}
+
+func (s *Services) BloodPressure() service.BloodPressureService {
+	if s.bloodPressureService != nil {
+		return s.bloodPressureService
+	}
+
+	s.bloodPressureService = NewBloodPressureService(s.storage.BloodPressure())
+
+	return s.bloodPressureService
+}
+
+func (s *Services) Hypertension() service.HypertensionService {
+	if s.hypertensionService != nil {
+		return s.hypertensionService
+	}
+
+	s.hypertensionService = NewHypertensionService(s.storage.Hypertension())
+
+	return s.hypertensionService
+}
+
+func (s *Services) Medications() service.MedicationsService {
+	if s.medicationsService != nil {
+		return s.medicationsService
+	}
+
+	s.medicationsService = NewMedicationsService(s.storage.Medications(), s.storage.MedicationIntakes())
+
+	return s.medicationsService
+}
+
+func (s *Services) Tests() service.TestsService {
+	if s.testsService != nil {
+		return s.testsService
+	}
+
+	s.testsService = NewTestsService(s.cfg.Questionnaires, s.storage.QuestionnaireSubmissions())
+
+	return s.testsService
+}
+
+func (s *Services) RiskCategory() service.RiskCategoryService {
+	if s.riskCategoryService != nil {
+		return s.riskCategoryService
+	}
+
+	s.riskCategoryService = NewRiskCategoryService(
+		s.Diseases(),
+		s.storage.BasicIndicators(),
+		s.Analysis(),
+		s.Score(),
+		s.BloodPressure(),
+		s.authClient,
+	)
+
+	return s.riskCategoryService
+}
+
+func (s *Services) LipidManagement() service.LipidManagementService {
+	if s.lipidManagementService != nil {
+		return s.lipidManagementService
+	}
+
+	s.lipidManagementService = NewLipidManagementService(s.Diseases(), s.Analysis(), s.RiskCategory())
+
+	return s.lipidManagementService
+}
+
+func (s *Services) MetabolicSyndrome() service.MetabolicSyndromeService {
+	if s.metabolicSyndromeService != nil {
+		return s.metabolicSyndromeService
+	}
+
+	s.metabolicSyndromeService = NewMetabolicSyndromeService(
+		s.storage.MetabolicSyndrome(),
+		s.Diseases(),
+		s.storage.BasicIndicators(),
+		s.storage.Analyses(),
+		s.storage.LabResults(),
+		s.storage.Glucose(),
+		s.BloodPressure(),
+	)
+
+	return s.metabolicSyndromeService
+}
+
+func (s *Services) Glucose() service.GlucoseService {
+	if s.glucoseService != nil {
+		return s.glucoseService
+	}
+
+	s.glucoseService = NewGlucoseService(
+		s.storage.Glucose(),
+		s.storage.LabResults(),
+		s.Diseases(),
+		s.RiskCategory(),
+	)
+
+	return s.glucoseService
+}
+
+func (s *Services) Activity() service.ActivityService {
+	if s.activityService != nil {
+		return s.activityService
+	}
+
+	s.activityService = NewActivityService(s.storage.Activity())
+
+	return s.activityService
+}
@@ -3,10 +3,14 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/cardio-analyst/backend/internal/gateway/domain/common"
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
 	"github.com/cardio-analyst/backend/internal/gateway/ports/client"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
 	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
 	"github.com/cardio-analyst/backend/internal/pkg/model"
 )
@@ -29,6 +33,19 @@ const (
 	sbpRange220to250 = "220-250 мм.рт.ст"
 )
 
+var activityTargetsStatisticsNames = map[string]string{
+	common.ActivityTargetBelow:  "Менее 150 минут в неделю",
+	common.ActivityTargetWithin: "150-300 минут в неделю",
+	common.ActivityTargetAbove:  "Более 300 минут в неделю",
+}
+
+var riskCategoriesStatisticsNames = map[string]string{
+	common.RiskCategoryLow:      "Низкий риск",
+	common.RiskCategoryModerate: "Умеренный риск",
+	common.RiskCategoryHigh:     "Высокий риск",
+	common.RiskCategoryVeryHigh: "Очень высокий риск",
+}
+
 type StatisticsService struct {
 	registrationPublisher client.Publisher
 
@@ -37,6 +54,11 @@ type StatisticsService struct {
 
 	diseases        storage.DiseasesRepository
 	basicIndicators storage.BasicIndicatorsRepository
+	analyses        storage.AnalysisRepository
+	bloodPressure   storage.BloodPressureRepository
+	activity        storage.ActivityRepository
+
+	score service.ScoreService
 }
 
 func NewStatisticsService(
@@ -45,6 +67,10 @@ func NewStatisticsService(
 	authClient client.Auth,
 	diseases storage.DiseasesRepository,
 	basicIndicators storage.BasicIndicatorsRepository,
+	analyses storage.AnalysisRepository,
+	bloodPressure storage.BloodPressureRepository,
+	activity storage.ActivityRepository,
+	score service.ScoreService,
 ) *StatisticsService {
 	return &StatisticsService{
 		registrationPublisher: registrationPublisher,
@@ -52,6 +78,10 @@ func NewStatisticsService(
 		authClient:            authClient,
 		diseases:              diseases,
 		basicIndicators:       basicIndicators,
+		analyses:              analyses,
+		bloodPressure:         bloodPressure,
+		activity:              activity,
+		score:                 score,
 	}
 }
 
@@ -188,3 +218,126 @@ func (s *StatisticsService) IdealCardiovascularAgesRangesByUsers(region string,
 
 	return result, nil
 }
+
+// RiskCategoriesByUsers counts the users by the ESC cardiovascular risk categories, the users with insufficient data
+// are not counted. The data of all the users is loaded at once and classified in memory the same way as by
+// service.RiskCategoryService, the users not found by the auth service are skipped.
+func (s *StatisticsService) RiskCategoriesByUsers(region string, _ map[uint64]bool) (map[string]int64, error) {
+	// the users are loaded by the region, so the region users are not needed
+	users, _, err := s.authClient.GetUsers(context.TODO(), model.UserCriteria{
+		Region: region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	diseases, err := s.diseases.All()
+	if err != nil {
+		return nil, err
+	}
+
+	basicIndicators, err := s.basicIndicators.All()
+	if err != nil {
+		return nil, err
+	}
+
+	analyses, err := s.analyses.All()
+	if err != nil {
+		return nil, err
+	}
+
+	measurements, err := s.bloodPressure.All()
+	if err != nil {
+		return nil, err
+	}
+
+	usersByIDs := make(map[uint64]model.User, len(users))
+	for _, user := range users {
+		usersByIDs[user.ID] = user
+	}
+
+	// the records are sorted by the user and from the newest to the oldest, so the order is kept for every user
+	basicIndicatorsByUsers := make(map[uint64][]*domain.BasicIndicators)
+	for i := range basicIndicators {
+		basicIndicator := &basicIndicators[i]
+		basicIndicatorsByUsers[basicIndicator.UserID] = append(basicIndicatorsByUsers[basicIndicator.UserID], basicIndicator)
+	}
+	analysesByUsers := make(map[uint64][]*domain.Analysis)
+	for _, analysis := range analyses {
+		analysesByUsers[analysis.UserID] = append(analysesByUsers[analysis.UserID], analysis)
+	}
+	measurementsByUsers := make(map[uint64][]*domain.BloodPressureMeasurement)
+	for _, measurement := range measurements {
+		measurementsByUsers[measurement.UserID] = append(measurementsByUsers[measurement.UserID], measurement)
+	}
+
+	result := make(map[string]int64, len(riskCategoriesStatisticsNames))
+	for _, name := range riskCategoriesStatisticsNames {
+		result[name] = 0
+	}
+
+	// every user has the diseases record created on the first access to the profile
+	for i := range diseases {
+		disease := &diseases[i]
+
+		user, found := usersByIDs[disease.UserID]
+		if !found {
+			continue
+		}
+
+		userBasicIndicators := basicIndicatorsByUsers[user.ID]
+		userAnalyses := analysesByUsers[user.ID]
+
+		// only gender is used, so the blood pressure diary is not needed
+		gender := domain.ExtractScoreDataFrom(userBasicIndicators, nil).Gender
+		domain.DeriveAnalyses(userAnalyses, userBasicIndicators, gender, user)
+
+		bloodPressureAverages := domain.BloodPressureWeeklyAverages(measurementsByUsers[user.ID])
+
+		riskCategory, err := classifyRiskCategory(
+			s.score, user, disease, userBasicIndicators, userAnalyses, bloodPressureAverages, false,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if riskCategory == nil {
+			continue
+		}
+
+		result[riskCategoriesStatisticsNames[riskCategory.Category]] += 1
+	}
+
+	return result, nil
+}
+
+// ActivityTargetsByUsers counts the users keeping the physical activity log (having the entries of the last
+// activityLogRecentDays days) by their moderate-equivalent activity of the last 7 days compared with the WHO target,
+// see domain.SummarizeRecentActivity. The week without entries is counted as below the target.
+func (s *StatisticsService) ActivityTargetsByUsers(region string, regionUsers map[uint64]bool) (map[string]int64, error) {
+	now := time.Now()
+
+	entries, err := s.activity.All(now.AddDate(0, 0, -activityLogRecentDays))
+	if err != nil {
+		return nil, err
+	}
+
+	entriesByUsers := make(map[uint64][]*domain.ActivityEntry)
+	for _, entry := range entries {
+		if region != "" && !regionUsers[entry.UserID] {
+			continue
+		}
+		entriesByUsers[entry.UserID] = append(entriesByUsers[entry.UserID], entry)
+	}
+
+	result := make(map[string]int64, len(activityTargetsStatisticsNames))
+	for _, name := range activityTargetsStatisticsNames {
+		result[name] = 0
+	}
+
+	for _, userEntries := range entriesByUsers {
+		summary := domain.SummarizeRecentActivity(userEntries, now)
+		result[activityTargetsStatisticsNames[summary.TargetStatus]] += 1
+	}
+
+	return result, nil
+}
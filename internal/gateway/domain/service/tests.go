@@ -0,0 +1,73 @@
+package service
+
+import (
+	"time"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+// check whether TestsService structure implements the service.TestsService interface
+var _ service.TestsService = (*TestsService)(nil)
+
+// TestsService implements service.TestsService interface.
+type TestsService struct {
+	questionnaires []*domain.QuestionnaireDefinition
+	submissions    storage.QuestionnaireSubmissionRepository
+}
+
+func NewTestsService(questionnaires []*domain.QuestionnaireDefinition, submissions storage.QuestionnaireSubmissionRepository) *TestsService {
+	return &TestsService{
+		questionnaires: questionnaires,
+		submissions:    submissions,
+	}
+}
+
+func (s *TestsService) FindAll() []*domain.QuestionnaireDefinition {
+	return s.questionnaires
+}
+
+func (s *TestsService) Get(code string) (*domain.QuestionnaireDefinition, error) {
+	for _, questionnaire := range s.questionnaires {
+		if questionnaire.Code == code {
+			return questionnaire, nil
+		}
+	}
+	return nil, domain.ErrQuestionnaireNotFound
+}
+
+func (s *TestsService) Submit(userID uint64, code string, answers domain.QuestionnaireAnswers) (*domain.QuestionnaireSubmission, error) {
+	questionnaire, err := s.Get(code)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := questionnaire.Evaluate(answers)
+	if err != nil {
+		return nil, err
+	}
+
+	submission := &domain.QuestionnaireSubmission{
+		UserID:  userID,
+		Code:    questionnaire.Code,
+		Version: questionnaire.Version,
+		Answers: answers,
+		Results: results,
+	}
+	submission.CreatedAt.Time = time.Now()
+
+	if submission.ID, err = s.submissions.Create(*submission); err != nil {
+		return nil, err
+	}
+
+	return submission, nil
+}
+
+func (s *TestsService) Submissions(userID uint64, code string) ([]*domain.QuestionnaireSubmission, error) {
+	if _, err := s.Get(code); err != nil {
+		return nil, err
+	}
+
+	return s.submissions.FindAll(userID, code)
+}
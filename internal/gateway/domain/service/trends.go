@@ -0,0 +1,49 @@
+package service
+
+import (
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/service"
+	"github.com/cardio-analyst/backend/internal/gateway/ports/storage"
+)
+
+// check whether TrendsService structure implements the service.TrendsService interface
+var _ service.TrendsService = (*TrendsService)(nil)
+
+// TrendsService implements service.TrendsService interface.
+type TrendsService struct {
+	trends   storage.TrendsRepository
+	labTests storage.LabTestRepository
+}
+
+func NewTrendsService(trends storage.TrendsRepository, labTests storage.LabTestRepository) *TrendsService {
+	return &TrendsService{
+		trends:   trends,
+		labTests: labTests,
+	}
+}
+
+func (s *TrendsService) Find(criteria domain.TrendsCriteria) ([]*domain.TrendSeries, error) {
+	if err := criteria.Validate(); err != nil {
+		return nil, err
+	}
+
+	series, err := s.trends.Find(criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	labTests, err := s.labTests.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	labTestsByCode := domain.LabTestsByCode(labTests)
+
+	for _, metricSeries := range series {
+		if labTest, found := labTestsByCode[metricSeries.Metric]; found {
+			metricSeries.Unit = labTest.Unit
+		}
+		metricSeries.Round()
+	}
+
+	return series, nil
+}
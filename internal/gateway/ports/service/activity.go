@@ -0,0 +1,16 @@
+package service
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+type ActivityService interface {
+	// Compendium returns the activities the entries may be recorded for with their MET values.
+	Compendium() (compendium []*domain.ActivityType)
+	Create(entry domain.ActivityEntry) (err error)
+	Delete(id, userID uint64) (err error)
+	FindAll(criteria domain.ActivityLogCriteria) (entries []*domain.ActivityEntry, err error)
+	// WeeklyProgress returns the weekly physical activity of the user entries according to the criteria compared with
+	// the WHO target, see domain.ActivityWeeklyProgresses.
+	WeeklyProgress(criteria domain.ActivityLogCriteria) (progresses []*domain.ActivityWeeklyProgress, err error)
+	// RecentSummary returns the user physical activity of the last 7 days, see domain.SummarizeRecentActivity.
+	RecentSummary(userID uint64) (summary *domain.ActivitySummary, err error)
+}
@@ -5,5 +5,11 @@ import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
 type AnalysisService interface {
 	Create(analysisData domain.Analysis) (err error)
 	Update(analysisData domain.Analysis) (err error)
+	// FindAll returns the user analyses with the derived values (eGFR, LDL cholesterol, atherogenicity coefficient)
+	// calculated, see domain.Analysis Derive, and the values flagged according to the reference ranges.
 	FindAll(userID uint64) (analysisDataList []*domain.Analysis, err error)
+	// Find returns the user analyses according to the criteria, the analyses are prepared the same way as by FindAll.
+	Find(criteria domain.RecordsCriteria) (analysisDataList []*domain.Analysis, nextCursor uint64, err error)
+	Delete(id, userID uint64) (err error)
+	History(id, userID uint64) (revisions []*domain.AnalysisRevision, err error)
 }
@@ -6,4 +6,7 @@ type BasicIndicatorsService interface {
 	Create(basicIndicatorsData domain.BasicIndicators) (err error)
 	Update(basicIndicatorsData domain.BasicIndicators) (err error)
 	FindAll(userID uint64) (basicIndicatorsDataList []*domain.BasicIndicators, err error)
+	Find(criteria domain.RecordsCriteria) (basicIndicatorsDataList []*domain.BasicIndicators, nextCursor uint64, err error)
+	Delete(id, userID uint64) (err error)
+	History(id, userID uint64) (revisions []*domain.BasicIndicatorsRevision, err error)
 }
@@ -0,0 +1,12 @@
+package service
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+type BloodPressureService interface {
+	Create(measurement domain.BloodPressureMeasurement) (err error)
+	Delete(id, userID uint64) (err error)
+	FindAll(criteria domain.BloodPressureDiaryCriteria) (measurements []*domain.BloodPressureMeasurement, err error)
+	// WeeklyAverages returns the weekly home blood pressure averages of the user measurements according to the
+	// criteria, see domain.BloodPressureWeeklyAverages.
+	WeeklyAverages(criteria domain.BloodPressureDiaryCriteria) (averages []*domain.BloodPressureWeeklyAverage, err error)
+}
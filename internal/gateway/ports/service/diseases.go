@@ -4,5 +4,15 @@ import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
 
 type DiseasesService interface {
 	Update(diseasesData domain.Diseases) (err error)
+	// Get returns the user diseases, TakesStatins is derived from the active lipid-lowering medications if the user
+	// keeps the medication list.
 	Get(userID uint64) (diseasesData *domain.Diseases, err error)
+	// Revisions returns the states of the user diseases saved on the updates sorted from the newest to the oldest.
+	Revisions(userID uint64) (revisions []*domain.Revision, err error)
+	// RevisionsDiff returns the changes of the user diseases between the revisions. The latest revision is used if toID
+	// is 0 and the revision preceding the to one is used if fromID is 0. If any of the revisions is not found,
+	// domain.ErrRevisionNotFound is returned.
+	RevisionsDiff(userID, fromID, toID uint64) (diff *domain.RevisionsDiff, err error)
+	// Evolution returns the changes made by each of the revisions of the user diseases from the newest to the oldest.
+	Evolution(userID uint64) (evolution []*domain.RevisionEvolution, err error)
 }
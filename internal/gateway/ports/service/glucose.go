@@ -0,0 +1,12 @@
+package service
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+type GlucoseService interface {
+	Create(measurement domain.GlucoseMeasurement) (err error)
+	Delete(id, userID uint64) (err error)
+	FindAll(criteria domain.GlucoseDiaryCriteria) (measurements []*domain.GlucoseMeasurement, err error)
+	// Control returns the user glycaemic targets and their attainment by the latest glycaemic diary values and lab
+	// results, see domain.EvaluateGlycaemicControl.
+	Control(userID uint64) (control *domain.GlycaemicControl, err error)
+}
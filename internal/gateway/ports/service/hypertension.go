@@ -0,0 +1,9 @@
+package service
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+type HypertensionService interface {
+	// History returns the user blood pressure categories over time with the transitions between them flagged, see
+	// domain.FlagHypertensionTransitions.
+	History(userID uint64) (history *domain.HypertensionHistory, err error)
+}
@@ -0,0 +1,17 @@
+package service
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+// LabTestsService manages the lab tests catalog.
+type LabTestsService interface {
+	Create(labTest domain.LabTest) (err error)
+	Update(labTest domain.LabTest) (err error)
+	Delete(code string) (err error)
+	FindAll() (labTests []*domain.LabTest, err error)
+}
+
+type LabResultsService interface {
+	// Create validates the results according to the lab tests catalog and saves them as the single collection.
+	Create(userID uint64, results []*domain.LabResult) (err error)
+	FindAll(userID uint64, testCode string) (results []*domain.LabResult, err error)
+}
@@ -5,4 +5,12 @@ import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
 type LifestyleService interface {
 	Update(lifestyleData domain.Lifestyle) (err error)
 	Get(userID uint64) (lifestyle *domain.Lifestyle, err error)
+	// Revisions returns the states of the user lifestyle saved on the updates sorted from the newest to the oldest.
+	Revisions(userID uint64) (revisions []*domain.Revision, err error)
+	// RevisionsDiff returns the changes of the user lifestyle between the revisions. The latest revision is used if toID
+	// is 0 and the revision preceding the to one is used if fromID is 0. If any of the revisions is not found,
+	// domain.ErrRevisionNotFound is returned.
+	RevisionsDiff(userID, fromID, toID uint64) (diff *domain.RevisionsDiff, err error)
+	// Evolution returns the changes made by each of the revisions of the user lifestyle from the newest to the oldest.
+	Evolution(userID uint64) (evolution []*domain.RevisionEvolution, err error)
 }
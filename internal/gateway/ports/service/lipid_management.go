@@ -0,0 +1,9 @@
+package service
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+type LipidManagementService interface {
+	// Get returns the user LDL cholesterol goal attainment and the statin therapy indication, see
+	// domain.EvaluateLipidManagement. domain.ErrNotEnoughDataToClassifyRisk is returned if the goal can not be derived.
+	Get(userID uint64) (lipidManagement *domain.LipidManagement, err error)
+}
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"time"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+)
+
+// MedicationsService manages the user medication list and the doses marked as taken.
+type MedicationsService interface {
+	Create(medication domain.Medication) (err error)
+	Update(medication domain.Medication) (err error)
+	Delete(id, userID uint64) (err error)
+	// FindAll returns the user medications with the Active flag set according to the current date.
+	FindAll(userID uint64) (medications []*domain.Medication, err error)
+	// CreateIntake marks the dose of the user medication as taken.
+	CreateIntake(intake domain.MedicationIntake) (err error)
+	DeleteIntake(id, userID uint64) (err error)
+	FindIntakes(userID uint64, from, to time.Time) (intakes []*domain.MedicationIntake, err error)
+	// Adherence calculates the objective adherence to the drug therapy within the criteria period, the last
+	// domain.DefaultAdherencePeriodDays days are used if the period bounds are not set.
+	Adherence(criteria domain.MedicationAdherenceCriteria) (adherence *domain.MedicationAdherence, err error)
+}
@@ -0,0 +1,14 @@
+package service
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+type MetabolicSyndromeService interface {
+	// Evaluate evaluates the metabolic syndrome criteria by the current user data (see domain.ScreenMetabolicSyndrome)
+	// without saving the result.
+	Evaluate(userID uint64) (screening *domain.MetabolicSyndromeScreening, err error)
+	// Screen evaluates the metabolic syndrome criteria the same way as Evaluate and saves the result as the result of
+	// the current date.
+	Screen(userID uint64) (screening *domain.MetabolicSyndromeScreening, err error)
+	// History returns the saved user screening results sorted from the newest to the oldest evaluation date.
+	History(userID uint64) (screenings []*domain.MetabolicSyndromeScreening, err error)
+}
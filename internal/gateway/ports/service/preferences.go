@@ -0,0 +1,8 @@
+package service
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+type PreferencesService interface {
+	Update(preferencesData domain.Preferences) (err error)
+	Get(userID uint64) (preferences *domain.Preferences, err error)
+}
@@ -4,6 +4,17 @@ import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
 
 type QuestionnaireService interface {
 	Get(userID uint64) (questionnaire *domain.Questionnaire, err error)
-	UpdateAnginaRose(questionnaire domain.Questionnaire) (err error)
+	// UpdateAnginaRose validates and classifies the answers to the Rose angina questionnaire, saves them and the result
+	UpdateAnginaRose(userID uint64, answers domain.AnginaRoseAnswers) (result *domain.AnginaRoseResult, err error)
+	// AnginaRoseResults returns the results of the Rose angina questionnaire from the newest to the oldest
+	AnginaRoseResults(userID uint64) (results []*domain.AnginaRoseResult, err error)
 	UpdateTreatmentAdherence(questionnaire domain.Questionnaire) (err error)
+	// Revisions returns the states of the user questionnaire saved on the updates sorted from the newest to the oldest.
+	Revisions(userID uint64) (revisions []*domain.Revision, err error)
+	// RevisionsDiff returns the changes of the user questionnaire between the revisions. The latest revision is used if toID
+	// is 0 and the revision preceding the to one is used if fromID is 0. If any of the revisions is not found,
+	// domain.ErrRevisionNotFound is returned.
+	RevisionsDiff(userID, fromID, toID uint64) (diff *domain.RevisionsDiff, err error)
+	// Evolution returns the changes made by each of the revisions of the user questionnaire from the newest to the oldest.
+	Evolution(userID uint64) (evolution []*domain.RevisionEvolution, err error)
 }
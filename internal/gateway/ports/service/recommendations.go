@@ -3,5 +3,7 @@ package service
 import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
 
 type RecommendationsService interface {
-	GetRecommendations(userID uint64) (recommendations []*domain.Recommendation, err error)
+	// GetRecommendations returns the user recommendations ranked by the expected risk reduction, the limit is the
+	// maximum number of the recommendations, 0 means no limit.
+	GetRecommendations(userID uint64, limit int) (recommendations []*domain.Recommendation, err error)
 }
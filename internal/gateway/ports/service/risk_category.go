@@ -0,0 +1,9 @@
+package service
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+type RiskCategoryService interface {
+	// Get classifies the user cardiovascular risk according to the ESC guidelines by the diseases, the analyses and
+	// the SCORE risk value, domain.ErrNotEnoughDataToClassifyRisk is returned if the data is insufficient.
+	Get(userID uint64) (riskCategory *domain.RiskCategory, err error)
+}
@@ -3,7 +3,20 @@ package service
 import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
 
 type ScoreService interface {
-	GetCVERisk(data domain.ScoreData) (riskValue uint64, scale string, err error)
-	GetIdealAge(data domain.ScoreData) (agesRange, scale string, err error)
+	GetCVERisk(data domain.ScoreData) (riskValue float64, scale string, err error)
+	GetCardiovascularAge(data domain.ScoreData) (cardiovascularAge *domain.CardiovascularAge, err error)
+	// Simulate calculates the risk values and cardiovascular ages for the "what-if" scenarios applied to the data.
+	Simulate(data domain.ScoreData, scenarios []*domain.ScoreScenario) (simulation *domain.ScoreSimulation, err error)
 	ResolveScale(riskValue float64, age int) string
+	ResolveRiskScope(region string) (riskScope string)
+	Ranges() (ranges domain.ScoreDataRanges)
+}
+
+// RiskModel estimates the 10-year risk of fatal and non-fatal cardiovascular events.
+type RiskModel interface {
+	// CVERisk returns the risk value in percents. By the time the method is used, it is assumed that the SCORE data
+	// is validated against the model Ranges.
+	CVERisk(data domain.ScoreData) (riskValue float64, err error)
+	// Ranges returns the SCORE data values ranges the model is applicable to.
+	Ranges() (ranges domain.ScoreDataRanges)
 }
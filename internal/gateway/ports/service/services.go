@@ -15,4 +15,17 @@ type Services interface {
 	Feedback() FeedbackService
 	Report() ReportService
 	Statistics() StatisticsService
+	Preferences() PreferencesService
+	LabTests() LabTestsService
+	LabResults() LabResultsService
+	Trends() TrendsService
+	BloodPressure() BloodPressureService
+	Hypertension() HypertensionService
+	Medications() MedicationsService
+	Tests() TestsService
+	RiskCategory() RiskCategoryService
+	LipidManagement() LipidManagementService
+	MetabolicSyndrome() MetabolicSyndromeService
+	Glucose() GlucoseService
+	Activity() ActivityService
 }
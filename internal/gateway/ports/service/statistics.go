@@ -12,4 +12,6 @@ type StatisticsService interface {
 	DiseasesByUsers(region string, regionUsers map[uint64]bool) (map[string]int64, error)
 	SBPByUsers(region string, regionUsers map[uint64]bool) (map[string]int64, error)
 	IdealCardiovascularAgesRangesByUsers(region string, regionUsers map[uint64]bool) (map[string]int64, error)
+	RiskCategoriesByUsers(region string, regionUsers map[uint64]bool) (map[string]int64, error)
+	ActivityTargetsByUsers(region string, regionUsers map[uint64]bool) (map[string]int64, error)
 }
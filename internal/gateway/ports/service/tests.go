@@ -0,0 +1,15 @@
+package service
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+// TestsService manages the questionnaires (tests) defined in the config and their submissions.
+type TestsService interface {
+	FindAll() (questionnaires []*domain.QuestionnaireDefinition)
+	// Get returns the questionnaire definition. If it is not found, domain.ErrQuestionnaireNotFound is returned.
+	Get(code string) (questionnaire *domain.QuestionnaireDefinition, err error)
+	// Submit validates the answers against the current questionnaire definition, calculates its scales and saves the
+	// submission.
+	Submit(userID uint64, code string, answers domain.QuestionnaireAnswers) (submission *domain.QuestionnaireSubmission, err error)
+	// Submissions returns the user submissions of the questionnaire from the newest to the oldest.
+	Submissions(userID uint64, code string) (submissions []*domain.QuestionnaireSubmission, err error)
+}
@@ -0,0 +1,9 @@
+package service
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+type TrendsService interface {
+	// Find returns the user metrics series resampled by the criteria period in SI units, the units of the lab tests
+	// are taken from the lab tests catalog.
+	Find(criteria domain.TrendsCriteria) (series []*domain.TrendSeries, err error)
+}
@@ -0,0 +1,19 @@
+package storage
+
+import (
+	"time"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+)
+
+// ActivityRepository encapsulates the logic of manipulations on the entity "ActivityEntry" (physical activity log) in
+// the database.
+type ActivityRepository interface {
+	Create(entry domain.ActivityEntry) (err error)
+	// Delete deletes the user entry. If the entry is not found, sql.ErrNoRows is returned.
+	Delete(id, userID uint64) (err error)
+	// FindAll searches for the user entries according to the criteria sorted from the newest to the oldest.
+	FindAll(criteria domain.ActivityLogCriteria) (entries []*domain.ActivityEntry, err error)
+	// All returns the entries of all the users performed since the time.
+	All(since time.Time) (entries []*domain.ActivityEntry, err error)
+}
@@ -6,8 +6,8 @@ import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
 type AnalysisRepository interface {
 	// Save is a symbiosis of update and insert methods (upsert).
 	//
-	// If the analysis data is in the database, then the data of the existing analysis is updated, otherwise the data
-	// of the new analysis is inserted.
+	// If the analysis data is in the database, then the data of the existing analysis is updated (the previous data is
+	// saved to the analysis history), otherwise the data of the new analysis is inserted.
 	Save(analysisData domain.Analysis) (err error)
 	// Get searches for the user analysis information in the database according to the analysis id.
 	//
@@ -17,4 +17,14 @@ type AnalysisRepository interface {
 	// FindAll searches for user analyses in the database according to the user id. If user analyses are not found,
 	// the method returns nil.
 	FindAll(userID uint64) (analysisDataList []*domain.Analysis, err error)
+	// Find searches for user analyses in the database according to the criteria. If there are more analyses than the
+	// criteria limit, the cursor of the next page is returned, otherwise it is 0.
+	Find(criteria domain.RecordsCriteria) (analysisDataList []*domain.Analysis, nextCursor uint64, err error)
+	// All returns the analyses of all the users sorted by the user and from the newest to the oldest.
+	All() (analysisDataList []*domain.Analysis, err error)
+	// Delete marks the user analysis as deleted, the deleted analyses are not returned by the other methods. If the
+	// analysis is not found, sql.ErrNoRows is returned.
+	Delete(id, userID uint64) (err error)
+	// History returns the previous data of the user analysis sorted from the newest to the oldest change.
+	History(id, userID uint64) (revisions []*domain.AnalysisRevision, err error)
 }
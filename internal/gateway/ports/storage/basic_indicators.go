@@ -6,8 +6,8 @@ import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
 type BasicIndicatorsRepository interface {
 	// Save is a symbiosis of update and insert methods (upsert).
 	//
-	// If the basic indicators data is in the database, then the data of the existing basic indicators is updated, otherwise the data
-	// of the new basic indicators is inserted.
+	// If the basic indicators data is in the database, then the data of the existing basic indicators is updated (the previous data
+	// is saved to the basic indicators history), otherwise the data of the new basic indicators is inserted.
 	Save(basicIndicatorsData domain.BasicIndicators) (err error)
 	// Get searches for the user basic indicators information in the database according to the basic indicators id.
 	//
@@ -17,6 +17,15 @@ type BasicIndicatorsRepository interface {
 	// FindAll searches for user analyses in the database according to the user id. If user analyses are not found,
 	// the method returns nil.
 	FindAll(userID uint64) (basicIndicatorsDataList []*domain.BasicIndicators, err error)
+	// Find searches for user basic indicators in the database according to the criteria. If there are more basic
+	// indicators than the criteria limit, the cursor of the next page is returned, otherwise it is 0.
+	Find(criteria domain.RecordsCriteria) (basicIndicatorsDataList []*domain.BasicIndicators, nextCursor uint64, err error)
+	// Delete marks the user basic indicators as deleted, the deleted basic indicators are not returned by the other
+	// methods. If the basic indicators are not found, sql.ErrNoRows is returned.
+	Delete(id, userID uint64) (err error)
+	// History returns the previous data of the user basic indicators sorted from the newest to the oldest change.
+	History(id, userID uint64) (revisions []*domain.BasicIndicatorsRevision, err error)
 
+	// All returns the basic indicators of all the users sorted by the user and from the newest to the oldest.
 	All() ([]domain.BasicIndicators, error)
 }
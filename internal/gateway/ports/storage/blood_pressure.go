@@ -0,0 +1,15 @@
+package storage
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+// BloodPressureRepository encapsulates the logic of manipulations on the entity "BloodPressureMeasurement" (home
+// blood pressure diary) in the database.
+type BloodPressureRepository interface {
+	Create(measurement domain.BloodPressureMeasurement) (err error)
+	// Delete deletes the user measurement. If the measurement is not found, sql.ErrNoRows is returned.
+	Delete(id, userID uint64) (err error)
+	// FindAll searches for the user measurements according to the criteria sorted from the newest to the oldest.
+	FindAll(criteria domain.BloodPressureDiaryCriteria) (measurements []*domain.BloodPressureMeasurement, err error)
+	// All returns the measurements of all the users sorted by the user and from the newest to the oldest.
+	All() (measurements []*domain.BloodPressureMeasurement, err error)
+}
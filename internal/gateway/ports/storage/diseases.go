@@ -13,4 +13,6 @@ type DiseasesRepository interface {
 	Get(userID uint64) (diseasesData *domain.Diseases, err error)
 
 	All() ([]domain.Diseases, error)
+	// Revisions returns the states of the user diseases saved on the updates sorted from the newest to the oldest.
+	Revisions(userID uint64) (revisions []*domain.Revision, err error)
 }
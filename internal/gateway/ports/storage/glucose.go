@@ -0,0 +1,13 @@
+package storage
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+// GlucoseRepository encapsulates the logic of manipulations on the entity "GlucoseMeasurement" (glycaemic diary) in
+// the database.
+type GlucoseRepository interface {
+	Create(measurement domain.GlucoseMeasurement) (err error)
+	// Delete deletes the user measurement. If the measurement is not found, sql.ErrNoRows is returned.
+	Delete(id, userID uint64) (err error)
+	// FindAll searches for the user measurements according to the criteria sorted from the newest to the oldest.
+	FindAll(criteria domain.GlucoseDiaryCriteria) (measurements []*domain.GlucoseMeasurement, err error)
+}
@@ -0,0 +1,11 @@
+package storage
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+// HypertensionRepository encapsulates the logic of the user blood pressure categories retrieval from the basic
+// indicators and the blood pressure diary in the database.
+type HypertensionRepository interface {
+	// FindAll returns the classified user readings sorted from the newest to the oldest, the transitions are not
+	// flagged.
+	FindAll(userID uint64) (classifications []*domain.HypertensionClassification, err error)
+}
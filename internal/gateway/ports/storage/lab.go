@@ -0,0 +1,29 @@
+package storage
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+// LabTestRepository encapsulates the logic of manipulations on the entity "LabTest" (lab tests catalog) in the database.
+type LabTestRepository interface {
+	// Create inserts the new lab test. If the test with the same code exists, domain.ErrLabTestAlreadyExists is
+	// returned.
+	Create(labTest domain.LabTest) (err error)
+	// Update updates the name and the validation range of the existing lab test, the unit of the test is not changed
+	// since the results are stored in it. If the test is not found, sql.ErrNoRows is returned.
+	Update(labTest domain.LabTest) (err error)
+	// Get searches for the lab test according to its code. If it is not found, sql.ErrNoRows is returned.
+	Get(code string) (labTest *domain.LabTest, err error)
+	// Delete deletes the lab test. If the test has results, domain.ErrLabTestInUse is returned, if it is not found,
+	// sql.ErrNoRows is returned.
+	Delete(code string) (err error)
+	FindAll() (labTests []*domain.LabTest, err error)
+}
+
+// LabResultRepository encapsulates the logic of manipulations on the entity "LabResult" in the database.
+type LabResultRepository interface {
+	// Create inserts the results of the same collection grouped by the new analysis. The analysis is listed among the
+	// user analyses only if any of the results is the analysis value (see domain.Analysis.LabTestValues).
+	Create(userID uint64, results []*domain.LabResult) (err error)
+	// FindAll searches for the user lab results sorted from the newest to the oldest. If testCode is not empty, only
+	// the results of this test are returned.
+	FindAll(userID uint64, testCode string) (results []*domain.LabResult, err error)
+}
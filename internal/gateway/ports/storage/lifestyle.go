@@ -5,4 +5,6 @@ import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
 type LifestyleRepository interface {
 	Update(lifestyleData domain.Lifestyle) (err error)
 	Get(userID uint64) (lifestyleData *domain.Lifestyle, err error)
+	// Revisions returns the states of the user lifestyle saved on the updates sorted from the newest to the oldest.
+	Revisions(userID uint64) (revisions []*domain.Revision, err error)
 }
@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"time"
+
+	domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+)
+
+// MedicationRepository encapsulates the logic of manipulations on the entity "Medication" (user medication list) in
+// the database.
+type MedicationRepository interface {
+	Create(medication domain.Medication) (err error)
+	// Update updates the user medication. If the medication is not found, sql.ErrNoRows is returned.
+	Update(medication domain.Medication) (err error)
+	// Get searches for the user medication. If it is not found, sql.ErrNoRows is returned.
+	Get(id, userID uint64) (medication *domain.Medication, err error)
+	// Delete deletes the user medication with its intakes. If the medication is not found, sql.ErrNoRows is returned.
+	Delete(id, userID uint64) (err error)
+	// FindAll searches for the user medications sorted from the latest started to the earliest started one.
+	FindAll(userID uint64) (medications []*domain.Medication, err error)
+}
+
+// MedicationIntakeRepository encapsulates the logic of manipulations on the entity "MedicationIntake" in the
+// database.
+type MedicationIntakeRepository interface {
+	Create(intake domain.MedicationIntake) (err error)
+	// Delete deletes the user intake. If the intake is not found, sql.ErrNoRows is returned.
+	Delete(id, userID uint64) (err error)
+	// FindAll searches for the user intakes taken within the dates range sorted from the newest to the oldest, the
+	// bounds are optional and inclusive.
+	FindAll(userID uint64, from, to time.Time) (intakes []*domain.MedicationIntake, err error)
+}
@@ -0,0 +1,12 @@
+package storage
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+// MetabolicSyndromeRepository encapsulates the logic of manipulations on the entity "MetabolicSyndromeScreening" in
+// the database.
+type MetabolicSyndromeRepository interface {
+	// Save saves the screening result, the result of the same user and evaluation date is replaced.
+	Save(screening domain.MetabolicSyndromeScreening) (id uint64, err error)
+	// FindAll searches for the user screening results sorted from the newest to the oldest evaluation date.
+	FindAll(userID uint64) (screenings []*domain.MetabolicSyndromeScreening, err error)
+}
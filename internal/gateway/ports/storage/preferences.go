@@ -0,0 +1,10 @@
+package storage
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+type PreferencesRepository interface {
+	Update(preferencesData domain.Preferences) (err error)
+	// Get searches for the user preferences in the database according to the user id. If they are not found, the
+	// default preferences are created.
+	Get(userID uint64) (preferencesData *domain.Preferences, err error)
+}
@@ -4,6 +4,11 @@ import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
 
 type QuestionnaireRepository interface {
 	Get(userID uint64) (questionnaire *domain.Questionnaire, err error)
-	UpdateAnginaRose(questionnaire domain.Questionnaire) (err error)
+	// SaveAnginaRose saves the result of the Rose angina questionnaire and updates the questionnaire angina score
+	SaveAnginaRose(result domain.AnginaRoseResult) (err error)
+	// FindAnginaRoseResults returns the results of the Rose angina questionnaire from the newest to the oldest
+	FindAnginaRoseResults(userID uint64) (results []*domain.AnginaRoseResult, err error)
 	UpdateTreatmentAdherence(questionnaire domain.Questionnaire) (err error)
+	// Revisions returns the states of the user questionnaire saved on the updates sorted from the newest to the oldest.
+	Revisions(userID uint64) (revisions []*domain.Revision, err error)
 }
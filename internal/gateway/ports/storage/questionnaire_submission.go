@@ -0,0 +1,11 @@
+package storage
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+// QuestionnaireSubmissionRepository encapsulates the logic of manipulations on the entity "QuestionnaireSubmission"
+// in the database.
+type QuestionnaireSubmissionRepository interface {
+	Create(submission domain.QuestionnaireSubmission) (id uint64, err error)
+	// FindAll searches for the user submissions of the questionnaire sorted from the newest to the oldest.
+	FindAll(userID uint64, code string) (submissions []*domain.QuestionnaireSubmission, err error)
+}
@@ -8,4 +8,16 @@ type Storage interface {
 	Questionnaire() QuestionnaireRepository
 	BasicIndicators() BasicIndicatorsRepository
 	Score() ScoreRepository
+	Preferences() PreferencesRepository
+	LabTests() LabTestRepository
+	LabResults() LabResultRepository
+	Trends() TrendsRepository
+	BloodPressure() BloodPressureRepository
+	Hypertension() HypertensionRepository
+	Medications() MedicationRepository
+	MedicationIntakes() MedicationIntakeRepository
+	QuestionnaireSubmissions() QuestionnaireSubmissionRepository
+	MetabolicSyndrome() MetabolicSyndromeRepository
+	Glucose() GlucoseRepository
+	Activity() ActivityRepository
 }
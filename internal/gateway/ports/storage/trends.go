@@ -0,0 +1,11 @@
+package storage
+
+import domain "github.com/cardio-analyst/backend/internal/gateway/domain/model"
+
+// TrendsRepository encapsulates the logic of the user metrics (basic indicators values and lab results) aggregation
+// in the database.
+type TrendsRepository interface {
+	// Find returns the series of the metrics resampled by the criteria period, the series are sorted by the metric
+	// and the buckets are sorted from the oldest to the newest. The metrics without measurements are omitted.
+	Find(criteria domain.TrendsCriteria) (series []*domain.TrendSeries, err error)
+}
@@ -45,7 +45,12 @@ type User struct {
 }
 
 func (u User) Age() int {
-	today := time.Now().In(u.BirthDate.Location())
+	return u.AgeAt(time.Now())
+}
+
+// AgeAt returns the number of full years of the user at the moment.
+func (u User) AgeAt(moment time.Time) int {
+	today := moment.In(u.BirthDate.Location())
 
 	ty, tm, td := today.Date()
 	today = time.Date(ty, tm, td, 0, 0, 0, 0, time.UTC)